@@ -0,0 +1,284 @@
+package gorest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// Canonicalize turns an interface into JSON following
+// the JSON Canonicalization Scheme (RFC 8785):
+// object keys are sorted, numbers are normalized and
+// there is no insignificant whitespace.
+// The output is byte-for-byte reproducible, suitable for signing and hashing.
+// Types implementing json.Marshaler, like chrono.Time and enums,
+// are marshalled by their own methods before canonicalization.
+func Canonicalize(v interface{}) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(buf)
+	dec.UseNumber()
+	var tree interface{}
+	if err := dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	out := new(bytes.Buffer)
+	if err := writeCanonical(out, tree); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+// DigestJSON returns the hex-encoded SHA-256 digest of the canonical JSON of v.
+func DigestJSON(v interface{}) (string, error) {
+	b, err := Canonicalize(v)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// SignJSON returns the hex-encoded HMAC-SHA256 of the canonical JSON of v.
+func SignJSON(v interface{}, key []byte) (string, error) {
+	b, err := Canonicalize(v)
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(b)
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// VerifyJSON checks a signature produced by SignJSON in constant time.
+func VerifyJSON(v interface{}, key []byte, signature string) bool {
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	b, err := Canonicalize(v)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(b)
+
+	return hmac.Equal(sig, mac.Sum(nil))
+}
+
+func writeCanonical(buf *bytes.Buffer, v interface{}) error {
+	switch x := v.(type) {
+	case nil:
+		buf.WriteString("null")
+
+	case bool:
+		buf.WriteString(strconv.FormatBool(x))
+
+	case string:
+		writeCanonicalString(buf, x)
+
+	case json.Number:
+		f, err := x.Float64()
+		if err != nil {
+			return err
+		}
+		if err := checkExactInteger(x, f); err != nil {
+			return err
+		}
+		s, err := formatCanonicalNumber(f)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(s)
+
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, e := range x {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonical(buf, e); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+
+	case map[string]interface{}:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		// Keys are sorted by their UTF-16 code units.
+		sort.Slice(keys, func(i, j int) bool {
+			return lessUTF16(keys[i], keys[j])
+		})
+
+		buf.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeCanonicalString(buf, k)
+			buf.WriteByte(':')
+			if err := writeCanonical(buf, x[k]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+
+	default:
+		return errors.New("canonical json: unexpected value")
+	}
+
+	return nil
+}
+
+func lessUTF16(a, b string) bool {
+	ua := utf16.Encode([]rune(a))
+	ub := utf16.Encode([]rune(b))
+
+	for i := 0; i < len(ua) && i < len(ub); i++ {
+		if ua[i] != ub[i] {
+			return ua[i] < ub[i]
+		}
+	}
+
+	return len(ua) < len(ub)
+}
+
+// writeCanonicalString escapes only the characters JSON requires.
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	const hexDigits = "0123456789abcdef"
+
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hexDigits[r>>4])
+				buf.WriteByte(hexDigits[r&0xF])
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// checkExactInteger rejects an integer literal, like an int64 ID,
+// that f, the float64 parsed from it, does not represent exactly.
+// RFC 8785 serializes all numbers as IEEE 754 doubles,
+// so such integers would be rounded and different values
+// could share the same canonical form.
+func checkExactInteger(n json.Number, f float64) error {
+	if strings.ContainsAny(string(n), ".eE") {
+		return nil
+	}
+
+	i, ok := new(big.Int).SetString(string(n), 10)
+	if !ok {
+		return fmt.Errorf("canonical json: invalid number %s", n)
+	}
+
+	if new(big.Float).SetInt(i).Cmp(big.NewFloat(f)) != 0 {
+		return fmt.Errorf("canonical json: integer %s cannot be represented exactly as a double", n)
+	}
+
+	return nil
+}
+
+// formatCanonicalNumber serializes a number
+// the way ECMAScript's Number.prototype.toString does.
+func formatCanonicalNumber(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", errors.New("canonical json: NaN and Infinity are not allowed")
+	}
+
+	if f == 0 {
+		return "0", nil
+	}
+
+	sign := ""
+	if f < 0 {
+		sign = "-"
+		f = -f
+	}
+
+	// Shortest digits that round-trip, in the form d.ddde±xx
+	s := strconv.FormatFloat(f, 'e', -1, 64)
+	mantissa, exp, _ := strings.Cut(s, "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+	e, err := strconv.Atoi(exp)
+	if err != nil {
+		return "", err
+	}
+
+	k := len(digits)
+	// The decimal point is after the n-th digit.
+	n := e + 1
+
+	switch {
+	case k <= n && n <= 21:
+		return sign + digits + strings.Repeat("0", n-k), nil
+
+	case 0 < n && n <= 21:
+		return sign + digits[:n] + "." + digits[n:], nil
+
+	case -6 < n && n <= 0:
+		return sign + "0." + strings.Repeat("0", -n) + digits, nil
+	}
+
+	expSign := "+"
+	if n-1 < 0 {
+		expSign = "-"
+	}
+	exp10 := n - 1
+	if exp10 < 0 {
+		exp10 = -exp10
+	}
+	expStr := expSign + strconv.Itoa(exp10)
+
+	if k == 1 {
+		return sign + digits + "e" + expStr, nil
+	}
+
+	return sign + digits[:1] + "." + digits[1:] + "e" + expStr, nil
+}
@@ -0,0 +1,163 @@
+package gorest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/FTChinese/go-rest/chrono"
+	"github.com/FTChinese/go-rest/enum"
+)
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+		want  string
+	}{
+		{
+			name: "Sorted keys and no whitespace",
+			input: map[string]interface{}{
+				"b": []int{3, 2, 1},
+				"a": map[string]string{"y": "<&>", "x": "中文"},
+			},
+			want: `{"a":{"x":"中文","y":"<&>"},"b":[3,2,1]}`,
+		},
+		{
+			name: "Keys sorted by UTF-16",
+			input: map[string]int{
+				"\u20ac":       1,
+				"\r":           2,
+				"\U0001f600":   3,
+				"\u0080":       4,
+				"1":            5,
+				"\u00f6":       6,
+				"\u05d0\u05d0": 7,
+			},
+			want: "{\"\\r\":2,\"1\":5,\"\u0080\":4,\"\u00f6\":6,\"\u05d0\u05d0\":7,\"\u20ac\":1,\"\U0001f600\":3}",
+		},
+		{
+			name: "Chrono and enum",
+			input: struct {
+				Tier      enum.Tier   `json:"tier"`
+				CreatedAt chrono.Time `json:"createdAt"`
+				Expire    chrono.Date `json:"expire"`
+			}{
+				Tier:      enum.TierPremium,
+				CreatedAt: chrono.TimeFrom(time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)),
+			},
+			want: `{"createdAt":"2021-01-02T03:04:05Z","expire":null,"tier":"premium"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Canonicalize(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Canonicalize() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalize_LargeInteger(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "Max safe integer",
+			input: map[string]int64{"id": 1<<53 - 1},
+			want:  `{"id":9007199254740991}`,
+		},
+		{
+			name:  "Power of two above 2^53",
+			input: map[string]int64{"id": 1 << 60},
+			// Exact, but printed with the shortest digits as RFC 8785 requires.
+			want: `{"id":1152921504606847000}`,
+		},
+		{
+			name:    "Rounded by float64",
+			input:   map[string]int64{"id": 123456789012345678},
+			wantErr: true,
+		},
+		{
+			name:    "Max uint64",
+			input:   []uint64{1<<64 - 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Canonicalize(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Canonicalize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Canonicalize() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatCanonicalNumber(t *testing.T) {
+	// Samples from RFC 8785 Appendix B.
+	tests := []struct {
+		input float64
+		want  string
+	}{
+		{0, "0"},
+		{-0.0, "0"},
+		{5e-324, "5e-324"},
+		{1.7976931348623157e308, "1.7976931348623157e+308"},
+		{9007199254740992, "9007199254740992"},
+		{-9007199254740992, "-9007199254740992"},
+		{295147905179352830000, "295147905179352830000"},
+		{9.999999999999997e22, "9.999999999999997e+22"},
+		{1e23, "1e+23"},
+		{1e21, "1e+21"},
+		{999999999999999700000, "999999999999999700000"},
+		{0.000001, "0.000001"},
+		{0.0000001, "1e-7"},
+		{4.34, "4.34"},
+		{1.5, "1.5"},
+		{333333333.3333332, "333333333.3333332"},
+	}
+	for _, tt := range tests {
+		got, err := formatCanonicalNumber(tt.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("formatCanonicalNumber(%v) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestSignJSON(t *testing.T) {
+	key := []byte("secret")
+	a := map[string]int{"a": 1, "b": 2}
+	b := map[string]float64{"b": 2.0, "a": 1.0}
+
+	sig, err := SignJSON(a, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !VerifyJSON(b, key, sig) {
+		t.Error("VerifyJSON() = false for the same content")
+	}
+
+	if VerifyJSON(map[string]int{"a": 2}, key, sig) {
+		t.Error("VerifyJSON() = true for different content")
+	}
+
+	d1, _ := DigestJSON(a)
+	d2, _ := DigestJSON(b)
+	if d1 != d2 {
+		t.Errorf("DigestJSON() = %s, %s", d1, d2)
+	}
+}
@@ -0,0 +1,164 @@
+package chrono
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Bucket is a calendar unit of time, like a day or an ISO week,
+// used to group data in reports.
+// The Period includes start and excludes end.
+type Bucket struct {
+	Granularity Granularity `json:"granularity"`
+	Period      Period      `json:"period"`
+}
+
+// MarshalJSON implements the Marshaler interface.
+// Key is added so that clients need not compute it.
+func (b Bucket) MarshalJSON() ([]byte, error) {
+	type bucket Bucket
+
+	return json.Marshal(struct {
+		bucket
+		Key string `json:"key"`
+	}{
+		bucket: bucket(b),
+		Key:    b.Key(),
+	})
+}
+
+// Contains tests whether t falls in the bucket.
+func (b Bucket) Contains(t time.Time) bool {
+	return b.Period.Contains(t)
+}
+
+// Truncate returns the start of the calendar unit t falls in, read in loc.
+// Weeks start on Monday.
+func (g Granularity) Truncate(t time.Time, loc *time.Location) Time {
+	return TimeFrom(g.truncate(t, loc))
+}
+
+// BucketOf returns the Bucket t falls in, read in loc.
+func (g Granularity) BucketOf(t time.Time, loc *time.Location) Bucket {
+	start := g.truncate(t, loc)
+
+	return Bucket{
+		Granularity: g,
+		Period: Period{
+			Start: TimeFrom(start),
+			End:   TimeFrom(g.next(start)),
+		},
+	}
+}
+
+// Buckets returns the contiguous buckets covering p in loc,
+// including those with no data.
+// The first and last buckets are whole calendar units,
+// which could extend beyond p.
+func Buckets(p Period, g Granularity, loc *time.Location) []Bucket {
+	var buckets []Bucket
+	if p.IsEmpty() {
+		return buckets
+	}
+
+	b := g.BucketOf(p.Start.Time, loc)
+	for p.Overlaps(b.Period) {
+		buckets = append(buckets, b)
+		b = g.BucketOf(b.Period.End.Time, loc)
+	}
+
+	return buckets
+}
+
+// SearchBuckets finds the index of the bucket t falls in,
+// or -1 if t is out of all buckets.
+// Buckets must be sorted and contiguous, like those returned by Buckets.
+func SearchBuckets(buckets []Bucket, t time.Time) int {
+	i := sort.Search(len(buckets), func(i int) bool {
+		return buckets[i].Period.End.After(t)
+	})
+
+	if i < len(buckets) && buckets[i].Contains(t) {
+		return i
+	}
+
+	return -1
+}
+
+// Key produces a stable identifier of the bucket,
+// like 2024-05-01, 2024-W18, 2024-05, 2024-Q2 or 2024.
+func (b Bucket) Key() string {
+	start := b.Period.Start.Time
+	y, m, d := start.Date()
+
+	switch b.Granularity {
+	case GranularityWeek:
+		wy, w := start.ISOWeek()
+		return fmt.Sprintf("%04d-W%02d", wy, w)
+	case GranularityMonth:
+		return fmt.Sprintf("%04d-%02d", y, m)
+	case GranularityQuarter:
+		return fmt.Sprintf("%04d-Q%d", y, quarterOf(m))
+	case GranularityYear:
+		return fmt.Sprintf("%04d", y)
+	default:
+		return fmt.Sprintf("%04d-%02d-%02d", y, m, d)
+	}
+}
+
+func quarterOf(m time.Month) int {
+	return (int(m)-1)/3 + 1
+}
+
+var bucketLabels = [...]map[Granularity]string{
+	LocaleEN: {
+		GranularityWeek:    "Week %[2]d, %[1]d",
+		GranularityQuarter: "Q%[2]d %[1]d",
+		GranularityYear:    "%d",
+	},
+	LocaleZhHans: {
+		GranularityWeek:    "%d年第%d周",
+		GranularityQuarter: "%d年第%d季度",
+		GranularityYear:    "%d年",
+	},
+	LocaleZhHant: {
+		GranularityWeek:    "%d年第%d週",
+		GranularityQuarter: "%d年第%d季度",
+		GranularityYear:    "%d年",
+	},
+}
+
+// Label produces a localized name of the bucket,
+// like "2024年第18周" or "Q2 2024".
+// The bucket is read in the location of its start.
+func (b Bucket) Label(l Locale) string {
+	if l < LocaleEN || l > LocaleZhHant {
+		l = LocaleEN
+	}
+
+	start := b.Period.Start.Time
+	y, m, _ := start.Date()
+
+	switch b.Granularity {
+	case GranularityWeek:
+		wy, w := start.ISOWeek()
+		return fmt.Sprintf(bucketLabels[l][GranularityWeek], wy, w)
+
+	case GranularityMonth:
+		if l == LocaleEN {
+			return start.Format("Jan 2006")
+		}
+		return start.Format("2006年1月")
+
+	case GranularityQuarter:
+		return fmt.Sprintf(bucketLabels[l][GranularityQuarter], y, quarterOf(m))
+
+	case GranularityYear:
+		return fmt.Sprintf(bucketLabels[l][GranularityYear], y)
+
+	default:
+		return start.Format(localePhrases[l].dateFmt)
+	}
+}
@@ -0,0 +1,116 @@
+package chrono
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestGranularity_BucketOf(t *testing.T) {
+	// 2024-03-31T17:00:00Z is 2024-04-01 01:00, a Monday in Shanghai.
+	ts := time.Date(2024, 3, 31, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		g       Granularity
+		key     string
+		labelEN string
+		labelCN string
+	}{
+		{GranularityDay, "2024-04-01", "Apr 1, 2024", "2024年4月1日"},
+		{GranularityWeek, "2024-W14", "Week 14, 2024", "2024年第14周"},
+		{GranularityMonth, "2024-04", "Apr 2024", "2024年4月"},
+		{GranularityQuarter, "2024-Q2", "Q2 2024", "2024年第2季度"},
+		{GranularityYear, "2024", "2024", "2024年"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.g.String(), func(t *testing.T) {
+			b := tt.g.BucketOf(ts, TZShanghai)
+			if !b.Contains(ts) {
+				t.Errorf("bucket %s should contain %s", b, ts)
+			}
+			if b.Key() != tt.key {
+				t.Errorf("Bucket.Key() = %s, want %s", b.Key(), tt.key)
+			}
+			if got := b.Label(LocaleEN); got != tt.labelEN {
+				t.Errorf("Bucket.Label(en) = %s, want %s", got, tt.labelEN)
+			}
+			if got := b.Label(LocaleZhHans); got != tt.labelCN {
+				t.Errorf("Bucket.Label(zh-Hans) = %s, want %s", got, tt.labelCN)
+			}
+		})
+	}
+
+	// The same instant is still in March in UTC.
+	if key := GranularityMonth.BucketOf(ts, time.UTC).Key(); key != "2024-03" {
+		t.Errorf("Bucket.Key() in UTC = %s", key)
+	}
+}
+
+func TestBuckets(t *testing.T) {
+	p, _ := NewPeriod(
+		time.Date(2024, 4, 29, 16, 0, 0, 0, time.UTC),
+		time.Date(2024, 5, 14, 16, 0, 0, 0, time.UTC),
+		BoundsClosedOpen,
+	)
+
+	weeks := Buckets(p, GranularityWeek, TZShanghai)
+
+	var keys []string
+	for i, b := range weeks {
+		keys = append(keys, b.Key())
+		if i > 0 && !b.Period.Start.Equal(weeks[i-1].Period.End.Time) {
+			t.Errorf("bucket %d is not contiguous", i)
+		}
+	}
+
+	want := []string{"2024-W18", "2024-W19", "2024-W20"}
+	if len(keys) != len(want) {
+		t.Fatalf("Buckets() = %v, want %v", keys, want)
+	}
+	for i := range want {
+		if keys[i] != want[i] {
+			t.Errorf("Buckets() = %v, want %v", keys, want)
+		}
+	}
+
+	if i := SearchBuckets(weeks, time.Date(2024, 5, 12, 16, 30, 0, 0, time.UTC)); i != 2 {
+		t.Errorf("SearchBuckets() = %d, want 2", i)
+	}
+	if i := SearchBuckets(weeks, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)); i != -1 {
+		t.Errorf("SearchBuckets() = %d, want -1", i)
+	}
+
+	quarters := Buckets(p, GranularityQuarter, TZShanghai)
+	if len(quarters) != 1 || quarters[0].Key() != "2024-Q2" {
+		t.Errorf("Buckets(quarter) = %v", quarters)
+	}
+}
+
+func TestBucket_MarshalJSON(t *testing.T) {
+	p, _ := NewPeriod(
+		time.Date(2024, 3, 31, 16, 0, 0, 0, time.UTC),
+		time.Date(2024, 5, 31, 16, 0, 0, 0, time.UTC),
+		BoundsClosedOpen,
+	)
+
+	b, err := json.Marshal(Buckets(p, GranularityMonth, TZShanghai))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `[` +
+		`{"granularity":"month","period":{"start":"2024-03-31T16:00:00Z","end":"2024-04-30T16:00:00Z","bounds":"[)"},"key":"2024-04"},` +
+		`{"granularity":"month","period":{"start":"2024-04-30T16:00:00Z","end":"2024-05-31T16:00:00Z","bounds":"[)"},"key":"2024-05"}` +
+		`]`
+	if string(b) != want {
+		t.Errorf("json.Marshal() = %s\nwant %s", b, want)
+	}
+
+	var got []Bucket
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[1].Granularity != GranularityMonth || !got[1].Period.Start.Equal(time.Date(2024, 4, 30, 16, 0, 0, 0, time.UTC)) {
+		t.Errorf("json.Unmarshal() = %+v", got)
+	}
+}
@@ -0,0 +1,247 @@
+package chrono
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"sort"
+	"sync"
+	"time"
+)
+
+// holidayFS holds the yearly public holidays of China.
+// Add a file for a new year once the State Council publishes it.
+//
+//go:embed holidays/*.json
+var holidayFS embed.FS
+
+// Holiday is a public holiday lasting from Start to End, both inclusive.
+type Holiday struct {
+	NameCN string `json:"name_cn"`
+	NameEN string `json:"name_en"`
+	Start  Date   `json:"start"`
+	End    Date   `json:"end"`
+}
+
+// HolidayYear is the arrangement of holidays published for a year.
+// Workdays are weekends adjusted to be workdays, i.e., 调休.
+type HolidayYear struct {
+	Year     int       `json:"year"`
+	Source   string    `json:"source"`
+	Holidays []Holiday `json:"holidays"`
+	Workdays []Date    `json:"workdays"`
+}
+
+// Validate checks the dates of a HolidayYear.
+func (y HolidayYear) Validate() error {
+	for _, h := range y.Holidays {
+		if h.Start.IsZero() || h.End.IsZero() {
+			return fmt.Errorf("holiday %s of %d missing dates", h.NameCN, y.Year)
+		}
+		if DaysBetween(h.Start, h.End) < 0 {
+			return fmt.Errorf("holiday %s of %d: %w", h.NameCN, y.Year, ErrEndBeforeStart)
+		}
+	}
+
+	for _, d := range y.Workdays {
+		if d.IsZero() {
+			return fmt.Errorf("adjusted workday of %d missing date", y.Year)
+		}
+	}
+
+	return nil
+}
+
+// Calendar tells workdays from holidays.
+// Days not covered by any HolidayYear follow the plain
+// Monday-to-Friday week.
+// Calendar days are read in UTC, the same as Date.String.
+type Calendar struct {
+	holidays map[int]Holiday // Keyed by days since epoch.
+	workdays map[int]bool
+	years    map[int]string // Year to source.
+}
+
+// NewCalendar creates a Calendar from yearly arrangements.
+func NewCalendar(years ...HolidayYear) (*Calendar, error) {
+	c := &Calendar{
+		holidays: make(map[int]Holiday),
+		workdays: make(map[int]bool),
+		years:    make(map[int]string),
+	}
+
+	for _, y := range years {
+		if err := c.Add(y); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// Add merges a yearly arrangement into the Calendar,
+// overriding days of the same dates.
+func (c *Calendar) Add(y HolidayYear) error {
+	if err := y.Validate(); err != nil {
+		return err
+	}
+
+	for _, h := range y.Holidays {
+		for d := h.Start.daysSinceEpoch(); d <= h.End.daysSinceEpoch(); d++ {
+			c.holidays[d] = h
+			delete(c.workdays, d)
+		}
+	}
+
+	for _, w := range y.Workdays {
+		c.workdays[w.daysSinceEpoch()] = true
+		delete(c.holidays, w.daysSinceEpoch())
+	}
+
+	c.years[y.Year] = y.Source
+
+	return nil
+}
+
+// LoadCalendar creates a Calendar from JSON files of HolidayYear
+// matching pattern in fsys, like os.DirFS("data") and "*.json".
+func LoadCalendar(fsys fs.FS, pattern string) (*Calendar, error) {
+	names, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no calendar files match %s", pattern)
+	}
+
+	sort.Strings(names)
+
+	years := make([]HolidayYear, 0, len(names))
+	for _, name := range names {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+
+		var y HolidayYear
+		if err := json.Unmarshal(data, &y); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		years = append(years, y)
+	}
+
+	return NewCalendar(years...)
+}
+
+var chinaCalendar = sync.OnceValue(func() *Calendar {
+	c, err := LoadCalendar(holidayFS, "holidays/*.json")
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+})
+
+// ChinaCalendar returns the embedded public holidays of mainland China.
+// The returned Calendar is shared and must not be modified;
+// create a new one with LoadCalendar to customize.
+func ChinaCalendar() *Calendar {
+	return chinaCalendar()
+}
+
+// Years returns the years covered by the Calendar in ascending order.
+func (c *Calendar) Years() []int {
+	years := make([]int, 0, len(c.years))
+	for y := range c.years {
+		years = append(years, y)
+	}
+
+	sort.Ints(years)
+
+	return years
+}
+
+// Covers tests whether the holidays of a year are known.
+func (c *Calendar) Covers(year int) bool {
+	_, ok := c.years[year]
+	return ok
+}
+
+// Holiday returns the Holiday d falls in.
+func (c *Calendar) Holiday(d Date) (Holiday, bool) {
+	h, ok := c.holidays[d.daysSinceEpoch()]
+	return h, ok
+}
+
+// IsWorkday tests whether d is a workday,
+// taking holidays and adjusted workdays into account.
+func (c *Calendar) IsWorkday(d Date) bool {
+	key := d.daysSinceEpoch()
+
+	if c.workdays[key] {
+		return true
+	}
+
+	if _, ok := c.holidays[key]; ok {
+		return false
+	}
+
+	wd := dateOf(d.civil()).Weekday()
+
+	return wd != time.Saturday && wd != time.Sunday
+}
+
+// AddWorkdays moves n workdays from d.
+// It moves backward if n is negative.
+// The result is always a workday unless n is 0,
+// in which case d is returned as is.
+func (c *Calendar) AddWorkdays(d Date, n int) Date {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	for n > 0 {
+		d = d.AddDays(step)
+		if c.IsWorkday(d) {
+			n--
+		}
+	}
+
+	return d
+}
+
+// WorkdaysBetween counts workdays from a (inclusive) to b (exclusive).
+// The result is negative if b is before a.
+func (c *Calendar) WorkdaysBetween(a, b Date) int {
+	sign := 1
+	if DaysBetween(a, b) < 0 {
+		a, b, sign = b, a, -1
+	}
+
+	count := 0
+	for d := a; DaysBetween(d, b) > 0; d = d.AddDays(1) {
+		if c.IsWorkday(d) {
+			count++
+		}
+	}
+
+	return sign * count
+}
+
+// IsWorkday tests d against ChinaCalendar.
+func IsWorkday(d Date) bool {
+	return ChinaCalendar().IsWorkday(d)
+}
+
+// AddWorkdays moves n workdays from d by ChinaCalendar.
+func AddWorkdays(d Date, n int) Date {
+	return ChinaCalendar().AddWorkdays(d, n)
+}
+
+// WorkdaysBetween counts workdays in [a, b) by ChinaCalendar.
+func WorkdaysBetween(a, b Date) int {
+	return ChinaCalendar().WorkdaysBetween(a, b)
+}
@@ -0,0 +1,91 @@
+package chrono
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestChinaCalendar_IsWorkday(t *testing.T) {
+	tests := []struct {
+		date string
+		want bool
+	}{
+		{"2024-02-09", true},  // Friday before Spring Festival.
+		{"2024-02-12", false}, // Monday in Spring Festival.
+		{"2024-02-18", true},  // Adjusted Sunday.
+		{"2024-02-24", false}, // Plain Saturday.
+		{"2023-10-07", true},  // Adjusted Saturday.
+		{"2025-10-08", false}, // Combined National Day and Mid-Autumn.
+		{"2030-01-07", true},  // Uncovered Monday.
+	}
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			if got := IsWorkday(mustDate(tt.date)); got != tt.want {
+				t.Errorf("IsWorkday() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+
+	h, ok := ChinaCalendar().Holiday(mustDate("2025-01-29"))
+	if !ok || h.NameCN != "春节" || h.NameEN != "Spring Festival" {
+		t.Errorf("Calendar.Holiday() = %v, %t", h, ok)
+	}
+
+	if !ChinaCalendar().Covers(2026) {
+		t.Error("ChinaCalendar should cover 2026")
+	}
+}
+
+func TestAddWorkdays(t *testing.T) {
+	tests := []struct {
+		date string
+		n    int
+		want string
+	}{
+		// 2024-02-08 Thu, 09 Fri, 10-17 off, 18 adjusted Sunday.
+		{"2024-02-08", 2, "2024-02-18"},
+		{"2024-02-18", -2, "2024-02-08"},
+		{"2024-09-27", 1, "2024-09-29"},
+		{"2024-09-27", 0, "2024-09-27"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			if got := AddWorkdays(mustDate(tt.date), tt.n).String(); got != tt.want {
+				t.Errorf("AddWorkdays() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	// 2024-10-01 to 2024-10-14: 8, 9, 10, 11, 12 (adjusted) are workdays.
+	if got := WorkdaysBetween(mustDate("2024-10-01"), mustDate("2024-10-14")); got != 5 {
+		t.Errorf("WorkdaysBetween() = %d, want 5", got)
+	}
+	if got := WorkdaysBetween(mustDate("2024-10-14"), mustDate("2024-10-01")); got != -5 {
+		t.Errorf("WorkdaysBetween() = %d, want -5", got)
+	}
+}
+
+func TestLoadCalendar(t *testing.T) {
+	fsys := fstest.MapFS{
+		"company/2030.json": {
+			Data: []byte(`{
+				"year": 2030,
+				"holidays": [{"name_cn": "司庆", "name_en": "Anniversary", "start": "2030-03-04", "end": "2030-03-04"}],
+				"workdays": ["2030-03-09"]
+			}`),
+		},
+	}
+
+	c, err := LoadCalendar(fsys, "company/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.IsWorkday(mustDate("2030-03-04")) || !c.IsWorkday(mustDate("2030-03-09")) {
+		t.Error("custom calendar not applied")
+	}
+
+	if _, err := LoadCalendar(fsys, "missing/*.json"); err == nil {
+		t.Error("LoadCalendar() should fail without files")
+	}
+}
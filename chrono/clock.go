@@ -0,0 +1,224 @@
+package chrono
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock tells the current time and creates timers.
+// Inject a FakeClock in tests so that expiration, renewal
+// and trial logic is deterministic.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is the subset of time.Timer used through a Clock.
+type Timer interface {
+	Chan() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// RealClock uses the system time.
+type RealClock struct{}
+
+// Now returns time.Now.
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+// After wraps time.After.
+func (RealClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// NewTimer wraps time.NewTimer.
+func (RealClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+type realTimer struct {
+	*time.Timer
+}
+
+func (t realTimer) Chan() <-chan time.Time {
+	return t.C
+}
+
+// FakeClock is a Clock whose time only changes when told to.
+// Timers fire when the clock is moved past their deadline.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+// NewFakeClock creates a FakeClock stopped at t.
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{now: t}
+}
+
+// Now returns the time the clock is stopped at.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// After is a shortcut of NewTimer(d).Chan().
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).Chan()
+}
+
+// NewTimer creates a timer firing once the clock is
+// set or advanced by d.
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	t := &fakeTimer{
+		clock: c,
+		c:     make(chan time.Time, 1),
+	}
+	t.Reset(d)
+
+	return t
+}
+
+// Set moves the clock to t, firing any timer due by then.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = t
+	c.fire()
+}
+
+// Advance moves the clock forward by d.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	c.fire()
+}
+
+// fire sends to the timers due by now and removes them.
+// Caller must hold the lock.
+func (c *FakeClock) fire() {
+	pending := c.timers[:0]
+	for _, t := range c.timers {
+		if t.deadline.After(c.now) {
+			pending = append(pending, t)
+			continue
+		}
+
+		select {
+		case t.c <- c.now:
+		default:
+		}
+	}
+
+	c.timers = pending
+}
+
+// remove drops t from the pending timers,
+// returning whether it was pending.
+// Caller must hold the lock.
+func (c *FakeClock) remove(t *fakeTimer) bool {
+	for i, pending := range c.timers {
+		if pending == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+type fakeTimer struct {
+	clock    *FakeClock
+	deadline time.Time
+	c        chan time.Time
+}
+
+func (t *fakeTimer) Chan() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	return t.clock.remove(t)
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	active := t.clock.remove(t)
+	t.deadline = t.clock.now.Add(d)
+	t.clock.timers = append(t.clock.timers, t)
+	t.clock.fire()
+
+	return active
+}
+
+var (
+	clockMu sync.RWMutex
+	clock   Clock = RealClock{}
+)
+
+// SetClock replaces the package-level Clock used by TimeNow,
+// DateNow and other constructors of current time.
+// Nil restores RealClock.
+// The returned function puts back the previous Clock,
+// which is handy to defer in tests.
+func SetClock(c Clock) (restore func()) {
+	if c == nil {
+		c = RealClock{}
+	}
+
+	clockMu.Lock()
+	prev := clock
+	clock = c
+	clockMu.Unlock()
+
+	return func() {
+		SetClock(prev)
+	}
+}
+
+// DefaultClock returns the package-level Clock.
+func DefaultClock() Clock {
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+
+	return clock
+}
+
+// now returns the current time of the package-level Clock.
+func now() time.Time {
+	return DefaultClock().Now()
+}
+
+type clockKey struct{}
+
+// WithClock returns a copy of ctx carrying c,
+// so that a request could be handled at a fixed time.
+func WithClock(ctx context.Context, c Clock) context.Context {
+	return context.WithValue(ctx, clockKey{}, c)
+}
+
+// ClockFrom returns the Clock carried by ctx,
+// or the package-level Clock if there is none.
+func ClockFrom(ctx context.Context) Clock {
+	if c, ok := ctx.Value(clockKey{}).(Clock); ok && c != nil {
+		return c
+	}
+
+	return DefaultClock()
+}
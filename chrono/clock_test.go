@@ -0,0 +1,79 @@
+package chrono
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestFakeClock_Timers(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+
+	timer := c.NewTimer(time.Hour)
+	after := c.After(2 * time.Hour)
+
+	c.Advance(30 * time.Minute)
+	select {
+	case <-timer.Chan():
+		t.Fatal("timer fired before deadline")
+	default:
+	}
+
+	c.Advance(30 * time.Minute)
+	select {
+	case got := <-timer.Chan():
+		if !got.Equal(start.Add(time.Hour)) {
+			t.Errorf("timer fired at %v", got)
+		}
+	default:
+		t.Fatal("timer not fired at deadline")
+	}
+
+	if timer.Stop() {
+		t.Error("Stop() = true on fired timer")
+	}
+
+	c.Set(start.Add(3 * time.Hour))
+	select {
+	case <-after:
+	default:
+		t.Fatal("After not fired")
+	}
+
+	stopped := c.NewTimer(time.Minute)
+	if !stopped.Stop() {
+		t.Error("Stop() = false on pending timer")
+	}
+	c.Advance(time.Hour)
+	select {
+	case <-stopped.Chan():
+		t.Error("stopped timer fired")
+	default:
+	}
+}
+
+func TestSetClock(t *testing.T) {
+	fixed := time.Date(2021, 1, 1, 20, 0, 0, 0, time.UTC)
+	restore := SetClock(NewFakeClock(fixed))
+	defer restore()
+
+	if got := TimeNow(); !got.Equal(fixed) {
+		t.Errorf("TimeNow() = %v", got)
+	}
+	if got := DateUTCNow().String(); got != "2021-01-01" {
+		t.Errorf("DateUTCNow() = %s", got)
+	}
+	if got := DateNowIn(TZShanghai).String(); got != "2021-01-02" {
+		t.Errorf("DateNowIn() = %s", got)
+	}
+
+	other := NewFakeClock(fixed.AddDate(1, 0, 0))
+	ctx := WithClock(context.Background(), other)
+	if ClockFrom(ctx) != other {
+		t.Error("ClockFrom() should return the clock in context")
+	}
+	if !ClockFrom(context.Background()).Now().Equal(fixed) {
+		t.Error("ClockFrom() should fall back to package-level clock")
+	}
+}
@@ -0,0 +1,456 @@
+package chrono
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronField describes the range and names of a cron field.
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronSecond = cronField{name: "second", min: 0, max: 59}
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDom    = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth  = cronField{
+		name: "month",
+		min:  1,
+		max:  12,
+		names: map[string]int{
+			"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+			"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+		},
+	}
+	// Both 0 and 7 are Sunday.
+	cronDow = cronField{
+		name: "day of week",
+		min:  0,
+		max:  7,
+		names: map[string]int{
+			"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+		},
+	}
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// Cron is a parsed cron schedule.
+// It only computes when the schedule fires and does not run jobs.
+//
+// Wall clock times are read in the schedule's location.
+// For zones with daylight saving time, a time skipped when
+// clocks go forward does not fire, and a time repeated when
+// clocks go back fires only once at its first occurrence.
+type Cron struct {
+	expr    string
+	second  uint64
+	minute  uint64
+	hour    uint64
+	dom     uint64
+	month   uint64
+	dow     uint64
+	domStar bool
+	dowStar bool
+	loc     *time.Location
+}
+
+// ParseCron parses a cron expression of 5 fields
+// (minute, hour, day of month, month, day of week),
+// 6 fields with seconds first, or a macro like @daily.
+// Fields accept *, ?, lists, ranges, steps and English names
+// of months and weekdays.
+// The schedule runs in TZShanghai unless the expression is
+// prefixed with CRON_TZ= or TZ=, like "CRON_TZ=Europe/London 0 9 * * MON".
+func ParseCron(expr string) (Cron, error) {
+	c := Cron{
+		expr: expr,
+		loc:  TZShanghai,
+	}
+
+	spec := strings.TrimSpace(expr)
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		_, rest, _ := strings.Cut(spec, "=")
+		name, fields, _ := strings.Cut(rest, " ")
+
+		loc, err := LoadZone(name)
+		if err != nil {
+			return Cron{}, fmt.Errorf("cron %q: %w", expr, err)
+		}
+
+		c.loc = loc
+		spec = strings.TrimSpace(fields)
+	}
+
+	if strings.HasPrefix(spec, "@") {
+		macro, ok := cronMacros[strings.ToLower(spec)]
+		if !ok {
+			return Cron{}, fmt.Errorf("cron %q: unknown macro %s", expr, spec)
+		}
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return Cron{}, fmt.Errorf("cron %q: expected 5 or 6 fields, got %d", expr, len(fields))
+	}
+
+	targets := []struct {
+		bits  *uint64
+		field cronField
+	}{
+		{&c.second, cronSecond},
+		{&c.minute, cronMinute},
+		{&c.hour, cronHour},
+		{&c.dom, cronDom},
+		{&c.month, cronMonth},
+		{&c.dow, cronDow},
+	}
+
+	for i, target := range targets {
+		b, err := parseCronField(fields[i], target.field)
+		if err != nil {
+			return Cron{}, fmt.Errorf("cron %q: %w", expr, err)
+		}
+		*target.bits = b
+	}
+
+	// Sunday could be written as 7.
+	if c.dow&(1<<7) != 0 {
+		c.dow = c.dow&^(1<<7) | 1
+	}
+
+	c.domStar = isCronStar(fields[3])
+	c.dowStar = isCronStar(fields[5])
+
+	return c, nil
+}
+
+// MustParseCron is like ParseCron but panics on error.
+// It is intended for package-level variables.
+func MustParseCron(expr string) Cron {
+	c, err := ParseCron(expr)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// isCronStar tells an unrestricted day field.
+// As in Vixie cron, a step like */2 still counts as unrestricted
+// when deciding how day of month and day of week are combined.
+func isCronStar(s string) bool {
+	return strings.HasPrefix(s, "*") || strings.HasPrefix(s, "?")
+}
+
+func parseCronField(s string, f cronField) (uint64, error) {
+	var b uint64
+	for _, part := range strings.Split(s, ",") {
+		pb, err := parseCronRange(part, f)
+		if err != nil {
+			return 0, err
+		}
+		b |= pb
+	}
+
+	return b, nil
+}
+
+// parseCronRange parses one of *, n, a-b, with an optional /step.
+func parseCronRange(s string, f cronField) (uint64, error) {
+	rng, stepStr, hasStep := strings.Cut(s, "/")
+
+	step := 1
+	if hasStep {
+		n, err := strconv.Atoi(stepStr)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid step %q in %s", s, f.name)
+		}
+		step = n
+	}
+
+	var lo, hi int
+	switch {
+	case rng == "*" || rng == "?":
+		lo, hi = f.min, f.max
+		if f.name == cronDow.name {
+			hi = 6
+		}
+	default:
+		first, last, isRange := strings.Cut(rng, "-")
+
+		var err error
+		lo, err = f.value(first)
+		if err != nil {
+			return 0, err
+		}
+
+		switch {
+		case isRange:
+			hi, err = f.value(last)
+			if err != nil {
+				return 0, err
+			}
+		case hasStep:
+			hi = f.max
+		default:
+			hi = lo
+		}
+	}
+
+	if lo > hi {
+		return 0, fmt.Errorf("invalid range %q in %s", s, f.name)
+	}
+
+	var b uint64
+	for i := lo; i <= hi; i += step {
+		b |= 1 << i
+	}
+
+	return b, nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if n, ok := f.names[strings.ToLower(s)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("invalid %s %q", f.name, s)
+	}
+
+	return n, nil
+}
+
+// String returns the original expression.
+func (c Cron) String() string {
+	return c.expr
+}
+
+// Location returns the zone the schedule runs in.
+func (c Cron) Location() *time.Location {
+	return c.loc
+}
+
+// In returns a copy of the schedule running in loc.
+func (c Cron) In(loc *time.Location) Cron {
+	c.loc = loc
+	return c
+}
+
+// matchDay tests the day of month, month and day of week.
+// If both day of month and day of week are restricted,
+// either of them matching is enough, the same as Vixie cron.
+func (c Cron) matchDay(t time.Time) bool {
+	if c.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+
+	domOK := c.dom&(1<<uint(t.Day())) != 0
+	dowOK := c.dow&(1<<uint(t.Weekday())) != 0
+
+	if c.domStar || c.dowStar {
+		return domOK && dowOK
+	}
+
+	return domOK || dowOK
+}
+
+// nextBit returns the lowest set bit at or above from, -1 if none.
+func nextBit(b uint64, from int) int {
+	if from > 63 {
+		return -1
+	}
+
+	b >>= uint(from)
+	if b == 0 {
+		return -1
+	}
+
+	return from + bits.TrailingZeros64(b)
+}
+
+// prevBit returns the highest set bit at or below from, -1 if none.
+func prevBit(b uint64, from int) int {
+	if from < 0 {
+		return -1
+	}
+
+	b <<= uint(63 - from)
+	if b == 0 {
+		return -1
+	}
+
+	return from - bits.LeadingZeros64(b)
+}
+
+// cronSearchDays limits the search for a schedule which never fires,
+// like Feb 30. 28 years cover every combination of Feb 29 and weekdays.
+const cronSearchDays = 28 * 366
+
+// wallMatches tests whether t shows the given wall clock,
+// which is false for a time skipped by daylight saving.
+func wallMatches(t time.Time, y int, mo time.Month, d, h, mi, s int) bool {
+	ty, tmo, td := t.Date()
+	th, tmi, ts := t.Clock()
+
+	return ty == y && tmo == mo && td == d && th == h && tmi == mi && ts == s
+}
+
+// firstOccurrence returns the earlier instant showing the same
+// wall clock as t when clocks went back shortly before t,
+// since time.Date does not promise which one it picks.
+func firstOccurrence(t time.Time) time.Time {
+	_, off := t.Zone()
+	_, prevOff := t.Add(-12 * time.Hour).Zone()
+	if prevOff <= off {
+		return t
+	}
+
+	e := t.Add(-time.Duration(prevOff-off) * time.Second)
+	y, mo, d := t.Date()
+	h, mi, s := t.Clock()
+	if wallMatches(e, y, mo, d, h, mi, s) {
+		return e
+	}
+
+	return t
+}
+
+// Next returns the first time the schedule fires strictly after t.
+// It returns zero time if the schedule never fires.
+func (c Cron) Next(t time.Time) time.Time {
+	start := t.In(c.loc).Truncate(time.Second).Add(time.Second)
+	sy, sm, sd := start.Date()
+	sh, smi, ss := start.Clock()
+
+	for i := 0; i < cronSearchDays; i++ {
+		day := time.Date(sy, sm, sd+i, 0, 0, 0, 0, time.UTC)
+		if !c.matchDay(day) {
+			continue
+		}
+		y, mo, d := day.Date()
+
+		first := i == 0
+		hFrom := 0
+		if first {
+			hFrom = sh
+		}
+
+		for h := nextBit(c.hour, hFrom); h >= 0; h = nextBit(c.hour, h+1) {
+			miFrom := 0
+			if first && h == sh {
+				miFrom = smi
+			}
+
+			for mi := nextBit(c.minute, miFrom); mi >= 0; mi = nextBit(c.minute, mi+1) {
+				sFrom := 0
+				if first && h == sh && mi == smi {
+					sFrom = ss
+				}
+
+				for s := nextBit(c.second, sFrom); s >= 0; s = nextBit(c.second, s+1) {
+					cand := firstOccurrence(time.Date(y, mo, d, h, mi, s, 0, c.loc))
+					if wallMatches(cand, y, mo, d, h, mi, s) && cand.After(t) {
+						return cand
+					}
+				}
+			}
+		}
+	}
+
+	return time.Time{}
+}
+
+// Prev returns the last time the schedule fired strictly before t.
+// It returns zero time if the schedule never fires.
+func (c Cron) Prev(t time.Time) time.Time {
+	start := t.In(c.loc).Truncate(time.Second)
+	if start.Equal(t) {
+		start = start.Add(-time.Second)
+	}
+	sy, sm, sd := start.Date()
+	sh, smi, ss := start.Clock()
+
+	for i := 0; i < cronSearchDays; i++ {
+		day := time.Date(sy, sm, sd-i, 0, 0, 0, 0, time.UTC)
+		if !c.matchDay(day) {
+			continue
+		}
+		y, mo, d := day.Date()
+
+		first := i == 0
+		hFrom := 23
+		if first {
+			hFrom = sh
+		}
+
+		for h := prevBit(c.hour, hFrom); h >= 0; h = prevBit(c.hour, h-1) {
+			miFrom := 59
+			if first && h == sh {
+				miFrom = smi
+			}
+
+			for mi := prevBit(c.minute, miFrom); mi >= 0; mi = prevBit(c.minute, mi-1) {
+				sFrom := 59
+				if first && h == sh && mi == smi {
+					sFrom = ss
+				}
+
+				for s := prevBit(c.second, sFrom); s >= 0; s = prevBit(c.second, s-1) {
+					cand := firstOccurrence(time.Date(y, mo, d, h, mi, s, 0, c.loc))
+					if wallMatches(cand, y, mo, d, h, mi, s) && cand.Before(t) {
+						return cand
+					}
+				}
+			}
+		}
+	}
+
+	return time.Time{}
+}
+
+// NextN returns the next n times the schedule fires after t.
+func (c Cron) NextN(t time.Time, n int) []time.Time {
+	times := make([]time.Time, 0, n)
+	for len(times) < n {
+		t = c.Next(t)
+		if t.IsZero() {
+			break
+		}
+		times = append(times, t)
+	}
+
+	return times
+}
+
+// Upcoming returns the next time the schedule fires after
+// the current time of clock, or of the package-level Clock if nil.
+func (c Cron) Upcoming(clock Clock) time.Time {
+	if clock == nil {
+		clock = DefaultClock()
+	}
+
+	return c.Next(clock.Now())
+}
@@ -0,0 +1,125 @@
+package chrono
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCron_Next(t *testing.T) {
+	sh := func(s string) time.Time {
+		ts, err := time.ParseInLocation(SQLDateTime, s, TZShanghai)
+		if err != nil {
+			panic(err)
+		}
+		return ts
+	}
+
+	tests := []struct {
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{"*/15 * * * *", sh("2024-05-01 10:07:30"), sh("2024-05-01 10:15:00")},
+		{"0 9 * * MON-FRI", sh("2024-05-03 10:00:00"), sh("2024-05-06 09:00:00")},
+		{"@daily", sh("2024-05-01 10:00:00"), sh("2024-05-02 00:00:00")},
+		{"0 0 31 * *", sh("2024-01-31 00:00:00"), sh("2024-03-31 00:00:00")},
+		{"0 0 1 * 1", sh("2024-05-01 00:00:00"), sh("2024-05-06 00:00:00")},
+		{"*/10 * * * * *", sh("2024-05-01 10:00:05"), sh("2024-05-01 10:00:10")},
+		{"0 0 29 2 *", sh("2024-03-01 00:00:00"), sh("2028-02-29 00:00:00")},
+		{"0 12 * * 7", sh("2024-05-01 00:00:00"), sh("2024-05-05 12:00:00")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			c, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := c.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Cron.Next() = %v, want %v", got, tt.want)
+			}
+			if got := c.Next(c.Prev(tt.want)); !got.Equal(tt.want) {
+				t.Errorf("Cron.Next(Cron.Prev()) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCron_Prev(t *testing.T) {
+	c := MustParseCron("0 9 * * *")
+	at := time.Date(2024, 5, 1, 9, 0, 0, 0, TZShanghai)
+
+	if got := c.Prev(at); !got.Equal(at.AddDate(0, 0, -1)) {
+		t.Errorf("Cron.Prev() = %v", got)
+	}
+	if got := c.Prev(at.Add(time.Nanosecond)); !got.Equal(at) {
+		t.Errorf("Cron.Prev() = %v", got)
+	}
+}
+
+func TestCron_DST(t *testing.T) {
+	ny := MustLoadZone("America/New_York")
+
+	// 02:30 does not exist on 2021-03-14.
+	c := MustParseCron("CRON_TZ=America/New_York 30 2 * * *")
+	got := c.Next(time.Date(2021, 3, 14, 0, 0, 0, 0, ny))
+	if want := time.Date(2021, 3, 15, 2, 30, 0, 0, ny); !got.Equal(want) {
+		t.Errorf("Cron.Next() in spring gap = %v, want %v", got, want)
+	}
+
+	// 01:30 happens twice on 2021-11-07 and fires once.
+	c = MustParseCron("30 1 * * *").In(ny)
+	times := c.NextN(time.Date(2021, 11, 7, 0, 0, 0, 0, ny), 2)
+	if len(times) != 2 ||
+		times[0].Format(time.RFC3339) != "2021-11-07T01:30:00-04:00" ||
+		times[1].Format(time.RFC3339) != "2021-11-08T01:30:00-05:00" {
+		t.Errorf("Cron.NextN() in fall back = %v", times)
+	}
+
+	// 01:30 happens twice on 2024-10-27 in London and fires in BST.
+	c = MustParseCron("CRON_TZ=Europe/London 30 1 * * *")
+	london := MustLoadZone("Europe/London")
+	got = c.Next(time.Date(2024, 10, 26, 12, 0, 0, 0, london))
+	if want := time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Cron.Next() in London fall back = %v, want %v", got, want)
+	}
+
+	// The second 01:30 does not fire again.
+	got = c.Next(time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC))
+	if want := time.Date(2024, 10, 28, 1, 30, 0, 0, london); !got.Equal(want) {
+		t.Errorf("Cron.Next() after first occurrence = %v, want %v", got, want)
+	}
+
+	got = c.Prev(time.Date(2024, 10, 27, 2, 0, 0, 0, london))
+	if want := time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Cron.Prev() in London fall back = %v, want %v", got, want)
+	}
+}
+
+func TestCron_Upcoming(t *testing.T) {
+	clock := NewFakeClock(time.Date(2024, 5, 1, 23, 59, 0, 0, TZShanghai))
+	c := MustParseCron("@hourly")
+
+	if got := c.Upcoming(clock); !got.Equal(time.Date(2024, 5, 2, 0, 0, 0, 0, TZShanghai)) {
+		t.Errorf("Cron.Upcoming() = %v", got)
+	}
+}
+
+func TestParseCron_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"61 * * * *",
+		"* * *",
+		"@every 5m",
+		"* * * * * * *",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"CRON_TZ=Mars/Base * * * * *",
+	} {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("ParseCron(%q) should fail", expr)
+		}
+	}
+
+	if got := MustParseCron("0 0 30 2 *").Next(time.Now()); !got.IsZero() {
+		t.Errorf("Cron.Next() of Feb 30 = %v", got)
+	}
+}
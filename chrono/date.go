@@ -34,17 +34,26 @@ func (d Date) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON converts ISO8601 data time into a Time struct.
+// Any of LenientLayouts is accepted and the date part is kept as written.
 // Empty string and null will be turned into time.Time zero value.
-func (d *Date) UnmarshalJSON(data []byte) (err error) {
-
-	if string(data) == "null" {
-		d.Time = time.Time{}
-		return
+func (d *Date) UnmarshalJSON(data []byte) error {
+	t, err := parseJSON(data)
+	if err != nil {
+		return err
 	}
 
-	d.Time, err = time.Parse(`"`+SQLDate+`"`, string(data))
+	d.Time = dateOrZero(t)
 
-	return
+	return nil
+}
+
+// dateOrZero keeps the date part of t in its own location.
+func dateOrZero(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
 }
 
 // Scan implements the Scanner interface.
@@ -55,19 +64,22 @@ func (d *Date) Scan(value interface{}) (err error) {
 		return nil
 	}
 
+	var t time.Time
 	switch v := value.(type) {
 	case time.Time:
 		d.Time = v
 		return
 	case []byte:
-		d.Time, err = time.Parse(SQLDate, string(v))
-		return
+		t, _, err = Parse(string(v), ParseLenient, time.UTC)
 	case string:
-		d.Time, err = time.Parse(SQLDate, v)
-		return
+		t, _, err = Parse(v, ParseLenient, time.UTC)
+	default:
+		return fmt.Errorf("can't convert %T to time.Time", value)
 	}
 
-	return fmt.Errorf("can't convert %T to time.Time", value)
+	d.Time = dateOrZero(t)
+
+	return
 }
 
 // Value implements the driver Valuer interface.
@@ -81,15 +93,16 @@ func (d Date) Value() (driver.Value, error) {
 }
 
 // DateNow creates current time.
+// Current time is read from the Clock set by SetClock.
 func DateNow() Date {
 	return Date{
-		time.Now().Truncate(24 * time.Hour),
+		now().Truncate(24 * time.Hour),
 	}
 }
 
 func DateUTCNow() Date {
 	return Date{
-		time.Now().UTC().Truncate(24 * time.Hour),
+		now().UTC().Truncate(24 * time.Hour),
 	}
 }
 
@@ -103,8 +116,42 @@ func DateFrom(t time.Time) Date {
 	return Date{t.Truncate(24 * time.Hour)}
 }
 
+// DateIn creates the Date of t as seen in loc.
+// For example, 2021-01-01T20:00:00Z is 2021-01-02 in Asia/Shanghai.
+func DateIn(t time.Time, loc *time.Location) Date {
+	return dateOf(t.In(loc).Date())
+}
+
+// DateNowIn creates today's Date in loc.
+func DateNowIn(loc *time.Location) Date {
+	return DateIn(now(), loc)
+}
+
+// TimeIn returns the midnight that starts the Date in loc.
+func (d Date) TimeIn(loc *time.Location) Time {
+	y, m, day := d.civil()
+	return Time{time.Date(y, m, day, 0, 0, 0, 0, loc)}
+}
+
 func DateUTCFrom(t time.Time) Date {
 	return Date{
 		t.UTC().Truncate(24 * time.Hour),
 	}
 }
+
+// MarshalText implements the encoding.TextMarshaler interface
+// with the same output as MarshalJSON, except that null is empty text.
+func (d Date) MarshalText() ([]byte, error) {
+	return jsonToText(d.MarshalJSON())
+}
+
+// AppendText implements the encoding.TextAppender interface. See appendText.
+func (d Date) AppendText(b []byte) ([]byte, error) {
+	return appendText(b, d)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (d *Date) UnmarshalText(text []byte) error {
+	return d.UnmarshalJSON(textToJSON(text))
+}
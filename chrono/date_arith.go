@@ -0,0 +1,95 @@
+package chrono
+
+import (
+	"time"
+
+	"github.com/FTChinese/go-rest/enum"
+)
+
+// daysIn returns the number of days in a month.
+func daysIn(y int, m time.Month) int {
+	return time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// addMonths adds months to t, keeping the clock and location.
+// If the day does not exist in the target month,
+// it is clamped to the last day of that month,
+// so that Jan 31 plus one month is Feb 28 or 29 rather than Mar 3.
+func addMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	h, mi, s := t.Clock()
+
+	// Normalize month with the first day so that it won't overflow.
+	first := time.Date(y, m+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+	ty, tm, _ := first.Date()
+
+	if last := daysIn(ty, tm); d > last {
+		d = last
+	}
+
+	return time.Date(ty, tm, d, h, mi, s, t.Nanosecond(), t.Location())
+}
+
+// civil returns the calendar date as rendered by String and Value,
+// which is always read in UTC so that it won't vary with
+// the time zone a service is running in.
+func (d Date) civil() (int, time.Month, int) {
+	return d.In(time.UTC).Date()
+}
+
+// dateOf creates a Date at UTC midnight.
+func dateOf(y int, m time.Month, day int) Date {
+	return Date{time.Date(y, m, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// AddDays adds n calendar days.
+func (d Date) AddDays(n int) Date {
+	y, m, day := d.civil()
+	return dateOf(y, m, day+n)
+}
+
+// AddMonths adds n months, clamping to the end of month if necessary.
+// For example, 2021-01-31 plus 1 month is 2021-02-28.
+func (d Date) AddMonths(n int) Date {
+	y, m, day := d.civil()
+	return Date{addMonths(time.Date(y, m, day, 0, 0, 0, 0, time.UTC), n)}
+}
+
+// AddYears adds n years. Feb 29 becomes Feb 28 in a common year.
+func (d Date) AddYears(n int) Date {
+	return d.AddMonths(12 * n)
+}
+
+// AddCycle adds n billing cycles.
+func (d Date) AddCycle(c enum.Cycle, n int) (Date, error) {
+	dur, err := CycleDuration(c)
+	if err != nil {
+		return d, err
+	}
+
+	return d.AddDuration(dur.Multiply(n)), nil
+}
+
+// StartOfMonth returns the first day of the month d is in.
+func (d Date) StartOfMonth() Date {
+	y, m, _ := d.civil()
+	return dateOf(y, m, 1)
+}
+
+// EndOfMonth returns the last day of the month d is in.
+func (d Date) EndOfMonth() Date {
+	y, m, _ := d.civil()
+	return dateOf(y, m, daysIn(y, m))
+}
+
+// daysSinceEpoch counts calendar days from 1970-01-01.
+func (d Date) daysSinceEpoch() int {
+	y, m, day := d.civil()
+	return int(time.Date(y, m, day, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// DaysBetween counts calendar days from a to b.
+// The result is negative if b is before a.
+func DaysBetween(a, b Date) int {
+	return b.daysSinceEpoch() - a.daysSinceEpoch()
+}
@@ -0,0 +1,111 @@
+package chrono
+
+import (
+	"testing"
+	"time"
+
+	"github.com/FTChinese/go-rest/enum"
+)
+
+func mustDate(s string) Date {
+	t, err := time.Parse(SQLDate, s)
+	if err != nil {
+		panic(err)
+	}
+
+	return Date{t}
+}
+
+func TestDate_AddMonths(t *testing.T) {
+	tests := []struct {
+		date string
+		n    int
+		want string
+	}{
+		{"2021-01-31", 1, "2021-02-28"},
+		{"2024-01-31", 1, "2024-02-29"},
+		{"2024-03-31", -1, "2024-02-29"},
+		{"2021-10-31", 2, "2021-12-31"},
+		{"2021-11-30", 3, "2022-02-28"},
+		{"2021-05-15", 12, "2022-05-15"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			if got := mustDate(tt.date).AddMonths(tt.n).String(); got != tt.want {
+				t.Errorf("Date.AddMonths() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_AddCycle(t *testing.T) {
+	tests := []struct {
+		name    string
+		date    string
+		cycle   enum.Cycle
+		n       int
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "Month from Jan 31",
+			date:  "2021-01-31",
+			cycle: enum.CycleMonth,
+			n:     1,
+			want:  "2021-02-28",
+		},
+		{
+			name:  "Year from leap day",
+			date:  "2024-02-29",
+			cycle: enum.CycleYear,
+			n:     1,
+			want:  "2025-02-28",
+		},
+		{
+			name:    "Invalid cycle",
+			date:    "2024-02-29",
+			cycle:   enum.CycleNull,
+			n:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mustDate(tt.date).AddCycle(tt.cycle, tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Date.AddCycle() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("Date.AddCycle() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_Month(t *testing.T) {
+	d := mustDate("2024-02-10")
+
+	if got := d.StartOfMonth().String(); got != "2024-02-01" {
+		t.Errorf("Date.StartOfMonth() = %s", got)
+	}
+	if got := d.EndOfMonth().String(); got != "2024-02-29" {
+		t.Errorf("Date.EndOfMonth() = %s", got)
+	}
+	if got := d.AddDays(20).String(); got != "2024-03-01" {
+		t.Errorf("Date.AddDays() = %s", got)
+	}
+}
+
+func TestDaysBetween(t *testing.T) {
+	// Dates created in different zones are compared by their calendar date.
+	a := Date{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).In(TZShanghai)}
+	b := mustDate("2024-03-01")
+
+	if got := DaysBetween(a, b); got != 60 {
+		t.Errorf("DaysBetween() = %d, want 60", got)
+	}
+	if got := DaysBetween(b, a); got != -60 {
+		t.Errorf("DaysBetween() = %d, want -60", got)
+	}
+}
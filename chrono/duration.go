@@ -0,0 +1,336 @@
+package chrono
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FTChinese/go-rest/enum"
+)
+
+// Duration is an ISO 8601 duration like P1Y2M3DT4H5M6S,
+// used for trial lengths, grace periods and add-ons.
+// The calendar part is kept apart from the clock part
+// since months and days vary in length.
+// Weeks like P2W are converted to days.
+type Duration struct {
+	Years  int
+	Months int
+	Days   int
+	Clock  time.Duration
+}
+
+// DurationOfDays creates a Duration of n days.
+func DurationOfDays(n int) Duration {
+	return Duration{Days: n}
+}
+
+// CycleDuration converts a billing cycle to its Duration.
+func CycleDuration(c enum.Cycle) (Duration, error) {
+	switch c {
+	case enum.CycleYear:
+		return Duration{Years: 1}, nil
+	case enum.CycleMonth:
+		return Duration{Months: 1}, nil
+	default:
+		return Duration{}, errors.New("not a valid cycle type")
+	}
+}
+
+// IsZero tests whether every part is zero.
+func (d Duration) IsZero() bool {
+	return d == Duration{}
+}
+
+// Multiply scales every part by n.
+func (d Duration) Multiply(n int) Duration {
+	return Duration{
+		Years:  d.Years * n,
+		Months: d.Months * n,
+		Days:   d.Days * n,
+		Clock:  d.Clock * time.Duration(n),
+	}
+}
+
+// AddTo adds the Duration to t.
+// Years and months are added first, clamping to the end of month,
+// then days and at last the clock part.
+func (d Duration) AddTo(t time.Time) time.Time {
+	if m := 12*d.Years + d.Months; m != 0 {
+		t = addMonths(t, m)
+	}
+
+	return t.AddDate(0, 0, d.Days).Add(d.Clock)
+}
+
+// AddDuration adds an ISO 8601 Duration calendar-correctly.
+func (t Time) AddDuration(d Duration) Time {
+	return Time{d.AddTo(t.Time)}
+}
+
+// AddDuration adds an ISO 8601 Duration to the calendar date.
+// The clock part only counts when it sums up to whole days.
+func (d Date) AddDuration(dur Duration) Date {
+	y, m, day := d.civil()
+	t := dur.AddTo(time.Date(y, m, day, 0, 0, 0, 0, time.UTC))
+
+	return dateOf(t.Date())
+}
+
+// String produces the ISO 8601 format.
+// Zero value is P0D.
+func (d Duration) String() string {
+	if d.IsZero() {
+		return "P0D"
+	}
+
+	var b strings.Builder
+	if d.isNegative() {
+		b.WriteByte('-')
+		d = d.Multiply(-1)
+	}
+	b.WriteByte('P')
+
+	writeDurationPart(&b, d.Years, 'Y')
+	writeDurationPart(&b, d.Months, 'M')
+	writeDurationPart(&b, d.Days, 'D')
+
+	if d.Clock == 0 {
+		return b.String()
+	}
+
+	b.WriteByte('T')
+
+	c := d.Clock
+	writeDurationPart(&b, int(c/time.Hour), 'H')
+	c %= time.Hour
+	writeDurationPart(&b, int(c/time.Minute), 'M')
+	c %= time.Minute
+
+	if c != 0 {
+		b.WriteString(strconv.FormatFloat(c.Seconds(), 'f', -1, 64))
+		b.WriteByte('S')
+	}
+
+	return b.String()
+}
+
+// isNegative tests whether no part is positive and some part is negative,
+// so that it could be formatted with a leading minus sign.
+func (d Duration) isNegative() bool {
+	if d.Years > 0 || d.Months > 0 || d.Days > 0 || d.Clock > 0 {
+		return false
+	}
+
+	return !d.IsZero()
+}
+
+func writeDurationPart(b *strings.Builder, n int, designator byte) {
+	if n == 0 {
+		return
+	}
+
+	b.WriteString(strconv.Itoa(n))
+	b.WriteByte(designator)
+}
+
+// ParseDuration parses an ISO 8601 duration like P1M, P7D, P2W or PT36H.
+// Each part could be negative, as could be the whole duration like -P1D.
+// Fractions are only allowed for seconds.
+func ParseDuration(s string) (Duration, error) {
+	invalid := fmt.Errorf("%s is not a valid ISO 8601 duration", s)
+
+	str := strings.ToUpper(strings.TrimSpace(s))
+
+	sign := 1
+	switch {
+	case strings.HasPrefix(str, "-"):
+		sign = -1
+		str = str[1:]
+	case strings.HasPrefix(str, "+"):
+		str = str[1:]
+	}
+
+	if len(str) < 3 || str[0] != 'P' {
+		return Duration{}, invalid
+	}
+	str = str[1:]
+
+	date, clock, hasClock := strings.Cut(str, "T")
+	if hasClock && clock == "" {
+		return Duration{}, invalid
+	}
+
+	var d Duration
+	for date != "" {
+		num, designator, rest, err := nextDurationPart(date)
+		if err != nil {
+			return Duration{}, invalid
+		}
+		n, err := strconv.Atoi(num)
+		if err != nil {
+			return Duration{}, invalid
+		}
+
+		switch designator {
+		case 'Y':
+			d.Years += n
+		case 'M':
+			d.Months += n
+		case 'W':
+			d.Days += 7 * n
+		case 'D':
+			d.Days += n
+		default:
+			return Duration{}, invalid
+		}
+		date = rest
+	}
+
+	for clock != "" {
+		num, designator, rest, err := nextDurationPart(clock)
+		if err != nil {
+			return Duration{}, invalid
+		}
+
+		var unit time.Duration
+		switch designator {
+		case 'H':
+			unit = time.Hour
+		case 'M':
+			unit = time.Minute
+		case 'S':
+			f, err := strconv.ParseFloat(strings.Replace(num, ",", ".", 1), 64)
+			if err != nil {
+				return Duration{}, invalid
+			}
+			d.Clock += time.Duration(f * float64(time.Second))
+			clock = rest
+			continue
+		default:
+			return Duration{}, invalid
+		}
+
+		n, err := strconv.Atoi(num)
+		if err != nil {
+			return Duration{}, invalid
+		}
+		d.Clock += time.Duration(n) * unit
+		clock = rest
+	}
+
+	return d.Multiply(sign), nil
+}
+
+// nextDurationPart splits the leading number and its designator.
+func nextDurationPart(s string) (num string, designator byte, rest string, err error) {
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return r >= 'A' && r <= 'Z'
+	})
+	if i <= 0 {
+		return "", 0, "", errors.New("missing number or designator")
+	}
+
+	return s[:i], s[i], s[i+1:], nil
+}
+
+// MarshalJSON produces the ISO 8601 string.
+// Zero value is turned into null.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Null and empty string will be turned into zero value.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if s == nil || *s == "" {
+		*d = Duration{}
+		return nil
+	}
+
+	tmp, err := ParseDuration(*s)
+	if err != nil {
+		return err
+	}
+
+	*d = tmp
+
+	return nil
+}
+
+// Scan implements the Scanner interface.
+// SQL NULL will be turned into zero value.
+// Integers are taken as days so that columns of loose days
+// could be read before migrated.
+func (d *Duration) Scan(value interface{}) (err error) {
+	switch v := value.(type) {
+	case nil:
+		*d = Duration{}
+		return nil
+	case int64:
+		*d = DurationOfDays(int(v))
+		return nil
+	case []byte:
+		return d.scanString(string(v))
+	case string:
+		return d.scanString(v)
+	}
+
+	return fmt.Errorf("can't convert %T to Duration", value)
+}
+
+func (d *Duration) scanString(s string) error {
+	if s == "" {
+		*d = Duration{}
+		return nil
+	}
+
+	if n, err := strconv.Atoi(s); err == nil {
+		*d = DurationOfDays(n)
+		return nil
+	}
+
+	tmp, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	*d = tmp
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// Zero value is turned into SQL NULL.
+func (d Duration) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+
+	return d.String(), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+// with the same output as MarshalJSON, except that null is empty text.
+func (d Duration) MarshalText() ([]byte, error) {
+	return jsonToText(d.MarshalJSON())
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (d *Duration) UnmarshalText(text []byte) error {
+	return d.UnmarshalJSON(textToJSON(text))
+}
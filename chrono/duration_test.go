@@ -0,0 +1,113 @@
+package chrono
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/FTChinese/go-rest/enum"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Duration
+		str     string
+		wantErr bool
+	}{
+		{in: "P1M", want: Duration{Months: 1}, str: "P1M"},
+		{in: "P7D", want: Duration{Days: 7}, str: "P7D"},
+		{in: "P2W", want: Duration{Days: 14}, str: "P14D"},
+		{in: "P1Y2M", want: Duration{Years: 1, Months: 2}, str: "P1Y2M"},
+		{
+			in:   "P1DT12H30M1.5S",
+			want: Duration{Days: 1, Clock: 12*time.Hour + 30*time.Minute + 1500*time.Millisecond},
+			str:  "P1DT12H30M1.5S",
+		},
+		{in: "PT36H", want: Duration{Clock: 36 * time.Hour}, str: "PT36H"},
+		{in: "-P1D", want: Duration{Days: -1}, str: "-P1D"},
+		{in: "P", wantErr: true},
+		{in: "P1DT", wantErr: true},
+		{in: "P1H", wantErr: true},
+		{in: "1D", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseDuration(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDuration() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseDuration() = %+v, want %+v", got, tt.want)
+			}
+			if got.String() != tt.str {
+				t.Errorf("Duration.String() = %s, want %s", got, tt.str)
+			}
+		})
+	}
+}
+
+func TestDate_AddDuration(t *testing.T) {
+	tests := []struct {
+		date string
+		dur  string
+		want string
+	}{
+		{"2021-01-31", "P1M", "2021-02-28"},
+		{"2021-01-31", "P1M1D", "2021-03-01"},
+		{"2024-02-29", "P1Y", "2025-02-28"},
+		{"2021-01-01", "P7D", "2021-01-08"},
+		{"2021-01-01", "PT48H", "2021-01-03"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date+"+"+tt.dur, func(t *testing.T) {
+			d, _ := ParseDuration(tt.dur)
+			if got := mustDate(tt.date).AddDuration(d).String(); got != tt.want {
+				t.Errorf("Date.AddDuration() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuration_JSONSQL(t *testing.T) {
+	type plan struct {
+		Trial Duration `json:"trial"`
+		Grace Duration `json:"grace"`
+	}
+
+	var p plan
+	if err := json.Unmarshal([]byte(`{"trial":"P7D","grace":null}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(p)
+	if string(b) != `{"trial":"P7D","grace":null}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+
+	var scanned Duration
+	if err := scanned.Scan(int64(30)); err != nil || scanned != DurationOfDays(30) {
+		t.Errorf("Duration.Scan(int64) = %v, %v", scanned, err)
+	}
+	if err := scanned.Scan([]byte("P1Y")); err != nil || scanned != (Duration{Years: 1}) {
+		t.Errorf("Duration.Scan([]byte) = %v, %v", scanned, err)
+	}
+
+	if v, _ := scanned.Value(); v != "P1Y" {
+		t.Errorf("Duration.Value() = %v", v)
+	}
+}
+
+func TestCycleDuration(t *testing.T) {
+	d, err := CycleDuration(enum.CycleMonth)
+	if err != nil || d != (Duration{Months: 1}) {
+		t.Errorf("CycleDuration(month) = %v, %v", d, err)
+	}
+
+	if _, err := CycleDuration(enum.CycleNull); err == nil {
+		t.Error("CycleDuration(null) should fail")
+	}
+}
@@ -0,0 +1,216 @@
+package chrono
+
+import (
+	"errors"
+	"fmt"
+)
+
+var errInvalidFestival = errors.New("not a valid festival")
+
+// Festival is a traditional Chinese festival whose Gregorian date
+// moves every year.
+type Festival int
+
+// Allowed values of Festival
+const (
+	FestivalLaba        Festival = iota // 腊八节, the 8th of month 12
+	FestivalXiaonian                    // 小年, the 23rd of month 12
+	FestivalNewYearsEve                 // 除夕, the last day of month 12
+	FestivalSpring                      // 春节
+	FestivalLantern                     // 元宵节
+	FestivalDragonHead                  // 龙抬头
+	FestivalQingming                    // 清明节, a solar term
+	FestivalDragonBoat                  // 端午节
+	FestivalQixi                        // 七夕
+	FestivalGhost                       // 中元节
+	FestivalMidAutumn                   // 中秋节
+	FestivalDoubleNinth                 // 重阳节
+)
+
+var festivalNames = [...]string{
+	"laba",
+	"xiaonian",
+	"new_years_eve",
+	"spring",
+	"lantern",
+	"dragon_head",
+	"qingming",
+	"dragon_boat",
+	"qixi",
+	"ghost",
+	"mid_autumn",
+	"double_ninth",
+}
+
+var festivalsCN = [...]string{
+	"腊八节",
+	"小年",
+	"除夕",
+	"春节",
+	"元宵节",
+	"龙抬头",
+	"清明节",
+	"端午节",
+	"七夕",
+	"中元节",
+	"中秋节",
+	"重阳节",
+}
+
+var festivalsEN = [...]string{
+	"Laba Festival",
+	"Little New Year",
+	"New Year's Eve",
+	"Spring Festival",
+	"Lantern Festival",
+	"Dragon Head Raising Day",
+	"Qingming Festival",
+	"Dragon Boat Festival",
+	"Qixi Festival",
+	"Ghost Festival",
+	"Mid-Autumn Festival",
+	"Double Ninth Festival",
+}
+
+// lunarFestivals are the lunar month and day of festivals.
+var lunarFestivals = map[Festival][2]int{
+	FestivalLaba:        {12, 8},
+	FestivalXiaonian:    {12, 23},
+	FestivalSpring:      {1, 1},
+	FestivalLantern:     {1, 15},
+	FestivalDragonHead:  {2, 2},
+	FestivalDragonBoat:  {5, 5},
+	FestivalQixi:        {7, 7},
+	FestivalGhost:       {7, 15},
+	FestivalMidAutumn:   {8, 15},
+	FestivalDoubleNinth: {9, 9},
+}
+
+func (f Festival) String() string {
+	if f < FestivalLaba || f > FestivalDoubleNinth {
+		return ""
+	}
+
+	return festivalNames[f]
+}
+
+// ParseFestival parses a string like "mid_autumn" into Festival.
+func ParseFestival(s string) (Festival, error) {
+	for i, name := range festivalNames {
+		if name == s {
+			return Festival(i), nil
+		}
+	}
+
+	return FestivalLaba, fmt.Errorf("%s is not a valid Festival", s)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f Festival) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (f *Festival) UnmarshalText(text []byte) error {
+	tmp, err := ParseFestival(string(text))
+	if err != nil {
+		return err
+	}
+
+	*f = tmp
+
+	return nil
+}
+
+// StringCN produces the Chinese name like 中秋节.
+func (f Festival) StringCN() string {
+	if f < FestivalLaba || f > FestivalDoubleNinth {
+		return ""
+	}
+
+	return festivalsCN[f]
+}
+
+// StringEN produces the English name like Mid-Autumn Festival.
+func (f Festival) StringEN() string {
+	if f < FestivalLaba || f > FestivalDoubleNinth {
+		return ""
+	}
+
+	return festivalsEN[f]
+}
+
+// DateIn returns the Gregorian date of the festival in the season of year.
+// Festivals of lunar month 12 are taken from the previous lunar year
+// so that they lead up to the Spring Festival of year,
+// e.g., FestivalNewYearsEve of 2025 is 2025-01-28.
+func (f Festival) DateIn(year int) (Date, error) {
+	switch f {
+	case FestivalQingming:
+		return SolarTermDate(year, SolarTermPureBrightness), nil
+
+	case FestivalNewYearsEve:
+		spring, err := FestivalSpring.DateIn(year)
+		if err != nil {
+			return Date{}, err
+		}
+		return spring.AddDays(-1), nil
+	}
+
+	md, ok := lunarFestivals[f]
+	if !ok {
+		return Date{}, errInvalidFestival
+	}
+
+	y := year
+	if md[0] == 12 {
+		y--
+	}
+
+	return LunarDate{Year: y, Month: md[0], Day: md[1]}.Date()
+}
+
+// Festivals returns the dates of all festivals in the season of year.
+func Festivals(year int) (map[Festival]Date, error) {
+	dates := make(map[Festival]Date, len(festivalNames))
+	for f := FestivalLaba; f <= FestivalDoubleNinth; f++ {
+		d, err := f.DateIn(year)
+		if err != nil {
+			return nil, err
+		}
+		dates[f] = d
+	}
+
+	return dates, nil
+}
+
+// FestivalOf tells the festival d falls on, if any.
+func FestivalOf(d Date) (Festival, bool) {
+	if _, ok := SolarTermOf(d); ok {
+		y, _, _ := d.civil()
+		if SolarTermDate(y, SolarTermPureBrightness).String() == d.String() {
+			return FestivalQingming, true
+		}
+	}
+
+	l, err := LunarDateOf(d)
+	if err != nil {
+		return 0, false
+	}
+
+	if l.IsLastDayOfYear() {
+		return FestivalNewYearsEve, true
+	}
+
+	if l.IsLeap {
+		return 0, false
+	}
+
+	for f, md := range lunarFestivals {
+		if md[0] == l.Month && md[1] == l.Day {
+			return f, true
+		}
+	}
+
+	return 0, false
+}
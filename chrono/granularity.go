@@ -0,0 +1,106 @@
+package chrono
+
+import (
+	"fmt"
+	"time"
+)
+
+// Granularity is the calendar unit used to split a Period or group time into buckets.
+type Granularity int
+
+// Allowed values of Granularity
+const (
+	GranularityDay Granularity = iota
+	GranularityWeek
+	GranularityMonth
+	GranularityQuarter
+	GranularityYear
+)
+
+var granularityNames = [...]string{
+	"day",
+	"week",
+	"month",
+	"quarter",
+	"year",
+}
+
+func (g Granularity) String() string {
+	if g < GranularityDay || g > GranularityYear {
+		return ""
+	}
+
+	return granularityNames[g]
+}
+
+// ParseGranularity parses a string like "week" into Granularity.
+func ParseGranularity(s string) (Granularity, error) {
+	for i, name := range granularityNames {
+		if name == s {
+			return Granularity(i), nil
+		}
+	}
+
+	return GranularityDay, fmt.Errorf("%s is not a valid Granularity", s)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (g Granularity) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (g *Granularity) UnmarshalText(text []byte) error {
+	tmp, err := ParseGranularity(string(text))
+	if err != nil {
+		return err
+	}
+
+	*g = tmp
+
+	return nil
+}
+
+// truncate returns the start of the calendar unit t falls in, read in loc.
+func (g Granularity) truncate(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+
+	switch g {
+	case GranularityWeek:
+		// Monday is the first day of a week.
+		offset := (int(time.Date(y, m, d, 0, 0, 0, 0, loc).Weekday()) + 6) % 7
+		return time.Date(y, m, d-offset, 0, 0, 0, 0, loc)
+
+	case GranularityMonth:
+		return time.Date(y, m, 1, 0, 0, 0, 0, loc)
+
+	case GranularityQuarter:
+		return time.Date(y, (m-1)/3*3+1, 1, 0, 0, 0, 0, loc)
+
+	case GranularityYear:
+		return time.Date(y, time.January, 1, 0, 0, 0, 0, loc)
+
+	default:
+		return time.Date(y, m, d, 0, 0, 0, 0, loc)
+	}
+}
+
+// next returns the start of the next calendar unit after a truncated time.
+func (g Granularity) next(t time.Time) time.Time {
+	switch g {
+	case GranularityWeek:
+		return t.AddDate(0, 0, 7)
+
+	case GranularityMonth:
+		return t.AddDate(0, 1, 0)
+
+	case GranularityQuarter:
+		return t.AddDate(0, 3, 0)
+
+	case GranularityYear:
+		return t.AddDate(1, 0, 0)
+
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
@@ -0,0 +1,379 @@
+package chrono
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Locale is the language a Humanizer speaks.
+type Locale int
+
+// Allowed values of Locale
+const (
+	LocaleEN Locale = iota
+	LocaleZhHans
+	LocaleZhHant
+)
+
+var localeNames = [...]string{
+	"en",
+	"zh-Hans",
+	"zh-Hant",
+}
+
+// ParseLocale parses a language tag into Locale.
+// Besides the names of Locale, zh-CN and zh-SG are taken as zh-Hans,
+// zh-TW, zh-HK and zh-MO as zh-Hant, and any en-* as en.
+func ParseLocale(s string) (Locale, error) {
+	switch strings.ToLower(strings.ReplaceAll(s, "_", "-")) {
+	case "zh-hans", "zh-cn", "zh-sg", "zh":
+		return LocaleZhHans, nil
+	case "zh-hant", "zh-tw", "zh-hk", "zh-mo":
+		return LocaleZhHant, nil
+	}
+
+	if lower := strings.ToLower(s); lower == "en" || strings.HasPrefix(lower, "en-") || strings.HasPrefix(lower, "en_") {
+		return LocaleEN, nil
+	}
+
+	return LocaleEN, fmt.Errorf("%s is not a valid Locale", s)
+}
+
+func (l Locale) String() string {
+	if l < LocaleEN || l > LocaleZhHant {
+		return ""
+	}
+
+	return localeNames[l]
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (l Locale) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It accepts the same tags as ParseLocale.
+func (l *Locale) UnmarshalText(text []byte) error {
+	tmp, err := ParseLocale(string(text))
+	if err != nil {
+		return err
+	}
+
+	*l = tmp
+
+	return nil
+}
+
+// Thresholds decide the unit a duration is rounded to.
+// A duration is expressed in the smallest unit whose rounded count
+// is less than the threshold of that unit.
+// Zero fields take the value of DefaultThresholds.
+type Thresholds struct {
+	Seconds int // Below it is "just now" in relative phrases.
+	Minutes int
+	Hours   int
+	Days    int
+	Months  int
+}
+
+// DefaultThresholds are the same as moment.js.
+var DefaultThresholds = Thresholds{
+	Seconds: 45,
+	Minutes: 45,
+	Hours:   22,
+	Days:    26,
+	Months:  11,
+}
+
+type timeUnit int
+
+const (
+	unitSecond timeUnit = iota
+	unitMinute
+	unitHour
+	unitDay
+	unitMonth
+	unitYear
+)
+
+var unitNames = [...][6]string{
+	LocaleEN:     {"second", "minute", "hour", "day", "month", "year"},
+	LocaleZhHans: {"秒", "分钟", "小时", "天", "个月", "年"},
+	LocaleZhHant: {"秒", "分鐘", "小時", "天", "個月", "年"},
+}
+
+// phrases used by a locale.
+type phrases struct {
+	justNow  string
+	future   string
+	past     string
+	calendar map[int]string // Keyed by days from today.
+	dateFmt  string
+	expiry   expiryPhrases
+}
+
+type expiryPhrases struct {
+	later     string
+	tomorrow  string
+	today     string
+	expToday  string
+	yesterday string
+	earlier   string
+}
+
+var localePhrases = [...]phrases{
+	LocaleEN: {
+		justNow: "just now",
+		future:  "in %s",
+		past:    "%s ago",
+		calendar: map[int]string{
+			-1: "yesterday",
+			0:  "today",
+			1:  "tomorrow",
+		},
+		dateFmt: "Jan 2, 2006",
+		expiry: expiryPhrases{
+			later:     "expires in %d days",
+			tomorrow:  "expires tomorrow",
+			today:     "expires today",
+			expToday:  "expired today",
+			yesterday: "expired yesterday",
+			earlier:   "expired %d days ago",
+		},
+	},
+	LocaleZhHans: {
+		justNow: "刚刚",
+		future:  "%s后",
+		past:    "%s前",
+		calendar: map[int]string{
+			-2: "前天",
+			-1: "昨天",
+			0:  "今天",
+			1:  "明天",
+			2:  "后天",
+		},
+		dateFmt: "2006年1月2日",
+		expiry: expiryPhrases{
+			later:     "还有%d天到期",
+			tomorrow:  "明天到期",
+			today:     "今天到期",
+			expToday:  "今天已过期",
+			yesterday: "昨天已过期",
+			earlier:   "已过期%d天",
+		},
+	},
+	LocaleZhHant: {
+		justNow: "剛剛",
+		future:  "%s後",
+		past:    "%s前",
+		calendar: map[int]string{
+			-2: "前天",
+			-1: "昨天",
+			0:  "今天",
+			1:  "明天",
+			2:  "後天",
+		},
+		dateFmt: "2006年1月2日",
+		expiry: expiryPhrases{
+			later:     "還有%d天到期",
+			tomorrow:  "明天到期",
+			today:     "今天到期",
+			expToday:  "今天已過期",
+			yesterday: "昨天已過期",
+			earlier:   "已過期%d天",
+		},
+	},
+}
+
+// Humanizer turns time into phrases like "3天前", "in 2 hours"
+// or "expired yesterday".
+// The zero value speaks English with DefaultThresholds,
+// reads current time from the package-level Clock
+// and decides calendar days in TZShanghai.
+type Humanizer struct {
+	Locale     Locale
+	Thresholds Thresholds
+	Clock      Clock
+	Location   *time.Location
+}
+
+// NewHumanizer creates a Humanizer for locale with default settings.
+func NewHumanizer(l Locale) Humanizer {
+	return Humanizer{
+		Locale:     l,
+		Thresholds: DefaultThresholds,
+	}
+}
+
+func (h Humanizer) now() time.Time {
+	if h.Clock == nil {
+		return now()
+	}
+
+	return h.Clock.Now()
+}
+
+func (h Humanizer) location() *time.Location {
+	if h.Location == nil {
+		return TZShanghai
+	}
+
+	return h.Location
+}
+
+// thresholds fills each zero field with DefaultThresholds,
+// so that setting only Days does not turn Months into 0.
+func (h Humanizer) thresholds() Thresholds {
+	th := h.Thresholds
+	for _, f := range []struct{ v, def *int }{
+		{&th.Seconds, &DefaultThresholds.Seconds},
+		{&th.Minutes, &DefaultThresholds.Minutes},
+		{&th.Hours, &DefaultThresholds.Hours},
+		{&th.Days, &DefaultThresholds.Days},
+		{&th.Months, &DefaultThresholds.Months},
+	} {
+		if *f.v == 0 {
+			*f.v = *f.def
+		}
+	}
+
+	return th
+}
+
+func (h Humanizer) phrases() phrases {
+	if h.Locale < LocaleEN || h.Locale > LocaleZhHant {
+		return localePhrases[LocaleEN]
+	}
+
+	return localePhrases[h.Locale]
+}
+
+// round rounds half away from zero, with at least 1.
+func round(f float64) int {
+	n := int(math.Round(f))
+	if n < 1 {
+		return 1
+	}
+
+	return n
+}
+
+// bucket rounds a non-negative duration to a count of unit.
+func (h Humanizer) bucket(d time.Duration) (int, timeUnit) {
+	th := h.thresholds()
+	secs := d.Seconds()
+
+	if n := int(math.Round(secs)); n < th.Seconds {
+		return n, unitSecond
+	}
+	if n := round(secs / 60); n < th.Minutes {
+		return n, unitMinute
+	}
+	if n := round(secs / 3600); n < th.Hours {
+		return n, unitHour
+	}
+
+	days := secs / 86400
+	if n := round(days); n < th.Days {
+		return n, unitDay
+	}
+	if n := round(days / 30.4375); n < th.Months {
+		return n, unitMonth
+	}
+
+	return round(days / 365.25), unitYear
+}
+
+func (h Humanizer) quantity(n int, u timeUnit) string {
+	l := h.Locale
+	if l < LocaleEN || l > LocaleZhHant {
+		l = LocaleEN
+	}
+
+	name := unitNames[l][u]
+	if l != LocaleEN {
+		return fmt.Sprintf("%d%s", n, name)
+	}
+
+	if n == 1 {
+		return "1 " + name
+	}
+
+	return fmt.Sprintf("%d %ss", n, name)
+}
+
+// Duration produces the length of d like "2 hours" or "3天".
+// Negative durations are treated as positive.
+func (h Humanizer) Duration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+
+	return h.quantity(h.bucket(d))
+}
+
+// Relative produces the phrase of t relative to now,
+// like "3天前", "in 2 hours" or "just now".
+func (h Humanizer) Relative(t time.Time) string {
+	d := t.Sub(h.now())
+	future := d > 0
+	if !future {
+		d = -d
+	}
+
+	p := h.phrases()
+
+	n, u := h.bucket(d)
+	if u == unitSecond {
+		return p.justNow
+	}
+
+	if future {
+		return fmt.Sprintf(p.future, h.quantity(n, u))
+	}
+
+	return fmt.Sprintf(p.past, h.quantity(n, u))
+}
+
+// daysFromToday counts calendar days from today to t in the Humanizer's location.
+func (h Humanizer) daysFromToday(t time.Time) int {
+	loc := h.location()
+	return DaysBetween(DateIn(h.now(), loc), DateIn(t, loc))
+}
+
+// Calendar produces 今天, 昨天 or 明天 and so on if t is
+// a nearby day, otherwise the date of t.
+func (h Humanizer) Calendar(t time.Time) string {
+	p := h.phrases()
+	if s, ok := p.calendar[h.daysFromToday(t)]; ok {
+		return s
+	}
+
+	return t.In(h.location()).Format(p.dateFmt)
+}
+
+// Expiry produces phrases of an expiration time,
+// like "还有5天到期" or "expired yesterday".
+// Days are counted by calendar days in the Humanizer's location.
+func (h Humanizer) Expiry(t time.Time) string {
+	p := h.phrases().expiry
+
+	switch days := h.daysFromToday(t); {
+	case days > 1:
+		return fmt.Sprintf(p.later, days)
+	case days == 1:
+		return p.tomorrow
+	case days == 0:
+		if t.After(h.now()) {
+			return p.today
+		}
+		return p.expToday
+	case days == -1:
+		return p.yesterday
+	default:
+		return fmt.Sprintf(p.earlier, -days)
+	}
+}
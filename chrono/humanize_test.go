@@ -0,0 +1,159 @@
+package chrono
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanizer_Relative(t *testing.T) {
+	now := time.Date(2021, 6, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		locale Locale
+		t      time.Time
+		want   string
+	}{
+		{LocaleEN, now.Add(-10 * time.Second), "just now"},
+		{LocaleEN, now.Add(2 * time.Hour), "in 2 hours"},
+		{LocaleEN, now.Add(-time.Minute), "1 minute ago"},
+		{LocaleEN, now.AddDate(0, 0, -3), "3 days ago"},
+		{LocaleEN, now.AddDate(0, 0, -45), "1 month ago"},
+		{LocaleEN, now.AddDate(2, 0, 0), "in 2 years"},
+		{LocaleZhHans, now.AddDate(0, 0, -3), "3天前"},
+		{LocaleZhHans, now.Add(90 * time.Minute), "2小时后"},
+		{LocaleZhHans, now.Add(-5 * time.Second), "刚刚"},
+		{LocaleZhHant, now.Add(30 * time.Minute), "30分鐘後"},
+		{LocaleZhHant, now.AddDate(0, -3, 0), "3個月前"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			h := NewHumanizer(tt.locale)
+			h.Clock = NewFakeClock(now)
+
+			if got := h.Relative(tt.t); got != tt.want {
+				t.Errorf("Humanizer.Relative() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHumanizer_Thresholds(t *testing.T) {
+	h := Humanizer{
+		Thresholds: Thresholds{
+			Seconds: 1,
+			Minutes: 60,
+			Hours:   24,
+			Days:    7,
+			Months:  12,
+		},
+	}
+
+	if got := h.Duration(50 * time.Minute); got != "50 minutes" {
+		t.Errorf("Humanizer.Duration() = %s", got)
+	}
+	if got := h.Duration(10 * 24 * time.Hour); got != "1 month" {
+		t.Errorf("Humanizer.Duration() = %s", got)
+	}
+}
+
+func TestHumanizer_PartialThresholds(t *testing.T) {
+	h := Humanizer{
+		Thresholds: Thresholds{Days: 7},
+	}
+
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{3 * 24 * time.Hour, "3 days"},
+		{10 * 24 * time.Hour, "1 month"},
+		{60 * 24 * time.Hour, "2 months"},
+		{400 * 24 * time.Hour, "1 year"},
+	}
+	for _, tt := range tests {
+		if got := h.Duration(tt.d); got != tt.want {
+			t.Errorf("Humanizer.Duration(%v) = %s, want %s", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestHumanizer_Calendar(t *testing.T) {
+	// 2021-06-15 in Shanghai.
+	now := time.Date(2021, 6, 14, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		locale Locale
+		t      time.Time
+		want   string
+	}{
+		{LocaleZhHans, now, "今天"},
+		{LocaleZhHans, now.Add(-24 * time.Hour), "昨天"},
+		{LocaleZhHant, now.Add(48 * time.Hour), "後天"},
+		{LocaleEN, now.Add(24 * time.Hour), "tomorrow"},
+		{LocaleEN, now.AddDate(0, 0, 5), "Jun 20, 2021"},
+		{LocaleZhHans, now.AddDate(0, 0, -10), "2021年6月5日"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			h := Humanizer{
+				Locale: tt.locale,
+				Clock:  NewFakeClock(now),
+			}
+
+			if got := h.Calendar(tt.t); got != tt.want {
+				t.Errorf("Humanizer.Calendar() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHumanizer_Expiry(t *testing.T) {
+	now := time.Date(2021, 6, 15, 4, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		locale Locale
+		t      time.Time
+		want   string
+	}{
+		{LocaleZhHans, now.AddDate(0, 0, 5), "还有5天到期"},
+		{LocaleZhHant, now.AddDate(0, 0, 5), "還有5天到期"},
+		{LocaleEN, now.AddDate(0, 0, 1), "expires tomorrow"},
+		{LocaleEN, now.Add(time.Hour), "expires today"},
+		{LocaleEN, now.Add(-time.Hour), "expired today"},
+		{LocaleEN, now.AddDate(0, 0, -1), "expired yesterday"},
+		{LocaleZhHans, now.AddDate(0, 0, -3), "已过期3天"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			h := Humanizer{
+				Locale: tt.locale,
+				Clock:  NewFakeClock(now),
+			}
+
+			if got := h.Expiry(tt.t); got != tt.want {
+				t.Errorf("Humanizer.Expiry() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	for s, want := range map[string]Locale{
+		"en-US":   LocaleEN,
+		"zh-CN":   LocaleZhHans,
+		"zh_TW":   LocaleZhHant,
+		"zh-Hant": LocaleZhHant,
+	} {
+		got, err := ParseLocale(s)
+		if err != nil || got != want {
+			t.Errorf("ParseLocale(%s) = %v, %v", s, got, err)
+		}
+	}
+
+	if _, err := ParseLocale("fr"); err == nil {
+		t.Error("ParseLocale(fr) should fail")
+	}
+}
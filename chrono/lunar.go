@@ -0,0 +1,362 @@
+package chrono
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrLunarOutOfRange is returned for dates the lunar table does not cover.
+var ErrLunarOutOfRange = errors.New("chrono: date out of the range of lunar calendar")
+
+// Range of lunar years covered by lunarInfo.
+const (
+	minLunarYear = 1900
+	maxLunarYear = 2100
+)
+
+// lunarInfo encodes lunar years from 1900 to 2100.
+// Bits 0-3 are the leap month, 0 if none;
+// bits 4-15 tell whether month 12 to 1 has 30 days, month 1 at bit 15;
+// bit 16 tells whether the leap month has 30 days.
+var lunarInfo = [...]int{
+	0x04bd8, 0x04ae0, 0x0a570, 0x054d5, 0x0d260, 0x0d950, 0x16554, 0x056a0, 0x09ad0, 0x055d2, // 1900
+	0x04ae0, 0x0a5b6, 0x0a4d0, 0x0d250, 0x1d255, 0x0b540, 0x0d6a0, 0x0ada2, 0x095b0, 0x14977, // 1910
+	0x04970, 0x0a4b0, 0x0b4b5, 0x06a50, 0x06d40, 0x1ab54, 0x02b60, 0x09570, 0x052f2, 0x04970, // 1920
+	0x06566, 0x0d4a0, 0x0ea50, 0x16a95, 0x05ad0, 0x02b60, 0x186e3, 0x092e0, 0x1c8d7, 0x0c950, // 1930
+	0x0d4a0, 0x1d8a6, 0x0b550, 0x056a0, 0x1a5b4, 0x025d0, 0x092d0, 0x0d2b2, 0x0a950, 0x0b557, // 1940
+	0x06ca0, 0x0b550, 0x15355, 0x04da0, 0x0a5b0, 0x14573, 0x052b0, 0x0a9a8, 0x0e950, 0x06aa0, // 1950
+	0x0aea6, 0x0ab50, 0x04b60, 0x0aae4, 0x0a570, 0x05260, 0x0f263, 0x0d950, 0x05b57, 0x056a0, // 1960
+	0x096d0, 0x04dd5, 0x04ad0, 0x0a4d0, 0x0d4d4, 0x0d250, 0x0d558, 0x0b540, 0x0b6a0, 0x195a6, // 1970
+	0x095b0, 0x049b0, 0x0a974, 0x0a4b0, 0x0b27a, 0x06a50, 0x06d40, 0x0af46, 0x0ab60, 0x09570, // 1980
+	0x04af5, 0x04970, 0x064b0, 0x074a3, 0x0ea50, 0x06b58, 0x05ac0, 0x0ab60, 0x096d5, 0x092e0, // 1990
+	0x0c960, 0x0d954, 0x0d4a0, 0x0da50, 0x07552, 0x056a0, 0x0abb7, 0x025d0, 0x092d0, 0x0cab5, // 2000
+	0x0a950, 0x0b4a0, 0x0baa4, 0x0ad50, 0x055d9, 0x04ba0, 0x0a5b0, 0x15176, 0x052b0, 0x0a930, // 2010
+	0x07954, 0x06aa0, 0x0ad50, 0x05b52, 0x04b60, 0x0a6e6, 0x0a4e0, 0x0d260, 0x0ea65, 0x0d530, // 2020
+	0x05aa0, 0x076a3, 0x096d0, 0x04afb, 0x04ad0, 0x0a4d0, 0x1d0b6, 0x0d250, 0x0d520, 0x0dd45, // 2030
+	0x0b5a0, 0x056d0, 0x055b2, 0x049b0, 0x0a577, 0x0a4b0, 0x0aa50, 0x1b255, 0x06d20, 0x0ada0, // 2040
+	0x14b63, 0x09370, 0x049f8, 0x04970, 0x064b0, 0x168a6, 0x0ea50, 0x06b20, 0x1a6c4, 0x0aae0, // 2050
+	0x092e0, 0x0d2e3, 0x0c960, 0x0d557, 0x0d4a0, 0x0da50, 0x05d55, 0x056a0, 0x0a6d0, 0x055d4, // 2060
+	0x052d0, 0x0a9b8, 0x0a950, 0x0b4a0, 0x0b6a6, 0x0ad50, 0x055a0, 0x0aba4, 0x0a5b0, 0x052b0, // 2070
+	0x0b273, 0x06930, 0x07337, 0x06aa0, 0x0ad50, 0x14b55, 0x04b60, 0x0a570, 0x054e4, 0x0d160, // 2080
+	0x0e968, 0x0d520, 0x0daa0, 0x16aa6, 0x056d0, 0x04ae0, 0x0a9d4, 0x0a2d0, 0x0d150, 0x0f252, // 2090
+	0x0d520, // 2100
+}
+
+// leapMonth returns the leap month of a lunar year, 0 if none.
+func leapMonth(y int) int {
+	return lunarInfo[y-minLunarYear] & 0xf
+}
+
+// lunarMonthDays returns the days of a month in a lunar year.
+func lunarMonthDays(y, m int, leap bool) int {
+	info := lunarInfo[y-minLunarYear]
+
+	bit := 0x10000 >> m
+	if leap {
+		bit = 0x10000
+	}
+
+	if info&bit != 0 {
+		return 30
+	}
+
+	return 29
+}
+
+// lunarYearDays returns the days of a lunar year.
+func lunarYearDays(y int) int {
+	days := 0
+	for m := 1; m <= 12; m++ {
+		days += lunarMonthDays(y, m, false)
+	}
+
+	if leapMonth(y) != 0 {
+		days += lunarMonthDays(y, leapMonth(y), true)
+	}
+
+	return days
+}
+
+// lunarNewYears holds the days since epoch of each lunar new year,
+// plus the day after the last covered lunar year ends.
+var lunarNewYears = func() [maxLunarYear - minLunarYear + 2]int {
+	var starts [maxLunarYear - minLunarYear + 2]int
+
+	// Lunar 1900-01-01 is 1900-01-31.
+	starts[0] = dateOf(1900, 1, 31).daysSinceEpoch()
+	for y := minLunarYear; y <= maxLunarYear; y++ {
+		starts[y-minLunarYear+1] = starts[y-minLunarYear] + lunarYearDays(y)
+	}
+
+	return starts
+}()
+
+// LunarDate is a date in the Chinese lunisolar calendar, i.e., 农历.
+// Month 1 is 正月. IsLeap tells a leap month, like 闰四月.
+type LunarDate struct {
+	Year   int  `json:"year"`
+	Month  int  `json:"month"`
+	Day    int  `json:"day"`
+	IsLeap bool `json:"isLeap"`
+}
+
+// LunarDateOf converts the calendar date of d to LunarDate.
+// Dates from 1900-01-31 to the end of lunar year 2100 are supported.
+func LunarDateOf(d Date) (LunarDate, error) {
+	n := d.daysSinceEpoch()
+	if n < lunarNewYears[0] || n >= lunarNewYears[len(lunarNewYears)-1] {
+		return LunarDate{}, ErrLunarOutOfRange
+	}
+
+	y := minLunarYear
+	for lunarNewYears[y-minLunarYear+1] <= n {
+		y++
+	}
+
+	offset := n - lunarNewYears[y-minLunarYear]
+	leap := leapMonth(y)
+
+	for m := 1; m <= 12; m++ {
+		days := lunarMonthDays(y, m, false)
+		if offset < days {
+			return LunarDate{Year: y, Month: m, Day: offset + 1}, nil
+		}
+		offset -= days
+
+		if m == leap {
+			days = lunarMonthDays(y, m, true)
+			if offset < days {
+				return LunarDate{Year: y, Month: m, Day: offset + 1, IsLeap: true}, nil
+			}
+			offset -= days
+		}
+	}
+
+	// Unreachable since offset is less than the days of the year.
+	return LunarDate{}, ErrLunarOutOfRange
+}
+
+// Validate checks that the LunarDate exists.
+func (l LunarDate) Validate() error {
+	if l.Year < minLunarYear || l.Year > maxLunarYear {
+		return ErrLunarOutOfRange
+	}
+
+	if l.Month < 1 || l.Month > 12 {
+		return fmt.Errorf("lunar month %d out of range", l.Month)
+	}
+
+	if l.IsLeap && leapMonth(l.Year) != l.Month {
+		return fmt.Errorf("lunar year %d has no leap month %d", l.Year, l.Month)
+	}
+
+	if l.Day < 1 || l.Day > lunarMonthDays(l.Year, l.Month, l.IsLeap) {
+		return fmt.Errorf("lunar day %d out of range", l.Day)
+	}
+
+	return nil
+}
+
+// Date converts to Gregorian Date.
+func (l LunarDate) Date() (Date, error) {
+	if err := l.Validate(); err != nil {
+		return Date{}, err
+	}
+
+	n := lunarNewYears[l.Year-minLunarYear]
+	leap := leapMonth(l.Year)
+
+	for m := 1; m < l.Month; m++ {
+		n += lunarMonthDays(l.Year, m, false)
+		if m == leap {
+			n += lunarMonthDays(l.Year, m, true)
+		}
+	}
+
+	if l.IsLeap {
+		n += lunarMonthDays(l.Year, l.Month, false)
+	}
+
+	n += l.Day - 1
+
+	return dateOf(1970, 1, 1+n), nil
+}
+
+// MonthDays returns the days of the month l is in,
+// or 0 if the year or month is out of range.
+func (l LunarDate) MonthDays() int {
+	if l.Year < minLunarYear || l.Year > maxLunarYear {
+		return 0
+	}
+
+	if l.Month < 1 || l.Month > 12 {
+		return 0
+	}
+
+	return lunarMonthDays(l.Year, l.Month, l.IsLeap)
+}
+
+// IsLastDayOfYear tests whether l is the eve of lunar new year, i.e., 除夕.
+func (l LunarDate) IsLastDayOfYear() bool {
+	if l.MonthDays() == 0 {
+		return false
+	}
+
+	if l.Month != 12 || l.IsLeap != (leapMonth(l.Year) == 12) {
+		return false
+	}
+
+	return l.Day == l.MonthDays()
+}
+
+var (
+	lunarMonthNames = [...]string{"", "正", "二", "三", "四", "五", "六", "七", "八", "九", "十", "冬", "腊"}
+	lunarDigits     = [...]string{"", "一", "二", "三", "四", "五", "六", "七", "八", "九", "十"}
+	heavenlyStems   = [...]string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"}
+	earthlyBranches = [...]string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉", "戌", "亥"}
+	zodiacsCN       = [...]string{"鼠", "牛", "虎", "兔", "龙", "蛇", "马", "羊", "猴", "鸡", "狗", "猪"}
+	zodiacsEN       = [...]string{"Rat", "Ox", "Tiger", "Rabbit", "Dragon", "Snake", "Horse", "Goat", "Monkey", "Rooster", "Dog", "Pig"}
+)
+
+// MonthCN produces the month like 正月 or 闰四月.
+func (l LunarDate) MonthCN() string {
+	if l.Month < 1 || l.Month > 12 {
+		return ""
+	}
+
+	name := lunarMonthNames[l.Month] + "月"
+	if l.IsLeap {
+		return "闰" + name
+	}
+
+	return name
+}
+
+// DayCN produces the day like 初一, 十五 or 廿三.
+func (l LunarDate) DayCN() string {
+	switch {
+	case l.Day < 1 || l.Day > 30:
+		return ""
+	case l.Day <= 10:
+		return "初" + lunarDigits[l.Day]
+	case l.Day < 20:
+		return "十" + lunarDigits[l.Day-10]
+	case l.Day == 20:
+		return "二十"
+	case l.Day < 30:
+		return "廿" + lunarDigits[l.Day-20]
+	default:
+		return "三十"
+	}
+}
+
+// YearGanZhi produces the sexagenary name of the year like 甲辰.
+func (l LunarDate) YearGanZhi() string {
+	// 1984 is 甲子.
+	i := ((l.Year-1984)%60 + 60) % 60
+	return heavenlyStems[i%10] + earthlyBranches[i%12]
+}
+
+// ZodiacCN produces the Chinese zodiac of the year like 龙.
+func (l LunarDate) ZodiacCN() string {
+	return zodiacsCN[((l.Year-1984)%12+12)%12]
+}
+
+// ZodiacEN produces the Chinese zodiac of the year in English like Dragon.
+func (l LunarDate) ZodiacEN() string {
+	return zodiacsEN[((l.Year-1984)%12+12)%12]
+}
+
+// String produces the format like 农历正月初一.
+func (l LunarDate) String() string {
+	return "农历" + l.MonthCN() + l.DayCN()
+}
+
+// StringWithYear produces the format like 农历甲辰年正月初一.
+func (l LunarDate) StringWithYear() string {
+	var b strings.Builder
+	b.WriteString("农历")
+	b.WriteString(l.YearGanZhi())
+	b.WriteString("年")
+	b.WriteString(l.MonthCN())
+	b.WriteString(l.DayCN())
+
+	return b.String()
+}
+
+// ParseLunarDate parses the output of LunarDate.MarshalText,
+// like 2024-04-15, or 2023-L02-10 for a leap month.
+func ParseLunarDate(s string) (LunarDate, error) {
+	var l LunarDate
+
+	ys, rest, ok1 := strings.Cut(s, "-")
+	ms, ds, ok2 := strings.Cut(rest, "-")
+	if !ok1 || !ok2 {
+		return LunarDate{}, fmt.Errorf("%s is not a valid LunarDate", s)
+	}
+
+	ms, l.IsLeap = strings.CutPrefix(ms, "L")
+
+	var err1, err2, err3 error
+	l.Year, err1 = strconv.Atoi(ys)
+	l.Month, err2 = strconv.Atoi(ms)
+	l.Day, err3 = strconv.Atoi(ds)
+	if err := errors.Join(err1, err2, err3); err != nil {
+		return LunarDate{}, fmt.Errorf("%s is not a valid LunarDate", s)
+	}
+
+	if err := l.Validate(); err != nil {
+		return LunarDate{}, err
+	}
+
+	return l, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It produces the format like 2024-04-15, with the month prefixed
+// by L if it is a leap month, like 2023-L02-10.
+// Zero value produces empty text.
+func (l LunarDate) MarshalText() ([]byte, error) {
+	if l == (LunarDate{}) {
+		return []byte{}, nil
+	}
+
+	leap := ""
+	if l.IsLeap {
+		leap = "L"
+	}
+
+	return []byte(fmt.Sprintf("%04d-%s%02d-%02d", l.Year, leap, l.Month, l.Day)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value.
+func (l *LunarDate) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*l = LunarDate{}
+		return nil
+	}
+
+	*l, err = ParseLunarDate(string(text))
+	return
+}
+
+// MarshalJSON implements the Marshaler interface.
+// It keeps the object form, which would otherwise be replaced
+// by the output of MarshalText.
+func (l LunarDate) MarshalJSON() ([]byte, error) {
+	type lunarDate LunarDate
+
+	return json.Marshal(lunarDate(l))
+}
+
+// UnmarshalJSON implements the Unmarshaler interface
+// to decode the object form.
+func (l *LunarDate) UnmarshalJSON(data []byte) error {
+	type lunarDate LunarDate
+
+	return json.Unmarshal(data, (*lunarDate)(l))
+}
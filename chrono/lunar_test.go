@@ -0,0 +1,166 @@
+package chrono
+
+import "testing"
+
+func TestLunarDateOf_NewYear(t *testing.T) {
+	// Chinese New Year of each year.
+	dates := []string{
+		"1900-01-31",
+		"1949-01-29",
+		"1970-02-06",
+		"2000-02-05",
+		"2020-01-25",
+		"2021-02-12",
+		"2022-02-01",
+		"2023-01-22",
+		"2024-02-10",
+		"2025-01-29",
+		"2026-02-17",
+		"2027-02-06",
+		"2030-02-03",
+	}
+
+	for _, date := range dates {
+		t.Run(date, func(t *testing.T) {
+			l, err := LunarDateOf(mustDate(date))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if l.Month != 1 || l.Day != 1 || l.IsLeap {
+				t.Errorf("LunarDateOf() = %+v", l)
+			}
+			if l.String() != "农历正月初一" {
+				t.Errorf("LunarDate.String() = %s", l)
+			}
+
+			prev, err := LunarDateOf(mustDate(date).AddDays(-1))
+			if err == nil && !prev.IsLastDayOfYear() {
+				t.Errorf("%+v should be the last day of lunar year", prev)
+			}
+		})
+	}
+}
+
+func TestLunarDate_RoundTrip(t *testing.T) {
+	tests := []struct {
+		date  string
+		lunar LunarDate
+		str   string
+	}{
+		{"2020-05-23", LunarDate{2020, 4, 1, true}, "农历闰四月初一"},
+		{"2023-03-22", LunarDate{2023, 2, 1, true}, "农历闰二月初一"},
+		{"2025-07-25", LunarDate{2025, 6, 1, true}, "农历闰六月初一"},
+		{"2024-09-17", LunarDate{2024, 8, 15, false}, "农历八月十五"},
+		{"2025-01-22", LunarDate{2024, 12, 23, false}, "农历腊月廿三"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			got, err := LunarDateOf(mustDate(tt.date))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.lunar {
+				t.Errorf("LunarDateOf() = %+v, want %+v", got, tt.lunar)
+			}
+			if got.String() != tt.str {
+				t.Errorf("LunarDate.String() = %s, want %s", got, tt.str)
+			}
+
+			d, err := tt.lunar.Date()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.String() != tt.date {
+				t.Errorf("LunarDate.Date() = %s, want %s", d, tt.date)
+			}
+		})
+	}
+
+	if _, err := (LunarDate{2024, 4, 1, true}).Date(); err == nil {
+		t.Error("2024 has no leap month 4")
+	}
+	if _, err := LunarDateOf(mustDate("1899-12-31")); err != ErrLunarOutOfRange {
+		t.Errorf("LunarDateOf() error = %v", err)
+	}
+
+	l := LunarDate{Year: 2024, Month: 1, Day: 1}
+	if l.StringWithYear() != "农历甲辰年正月初一" || l.ZodiacCN() != "龙" || l.ZodiacEN() != "Dragon" {
+		t.Errorf("year names = %s %s %s", l.StringWithYear(), l.ZodiacCN(), l.ZodiacEN())
+	}
+
+	var zero LunarDate
+	if zero.MonthDays() != 0 || zero.IsLastDayOfYear() {
+		t.Errorf("zero LunarDate: MonthDays() = %d, IsLastDayOfYear() = %t", zero.MonthDays(), zero.IsLastDayOfYear())
+	}
+	if (LunarDate{Year: 2024, Month: 13, Day: 1}).MonthDays() != 0 {
+		t.Error("MonthDays() of month 13 should be 0")
+	}
+}
+
+func TestSolarTermDate(t *testing.T) {
+	tests := []struct {
+		year int
+		term SolarTerm
+		want string
+	}{
+		{2024, SolarTermStartOfSpring, "2024-02-04"},
+		{2025, SolarTermStartOfSpring, "2025-02-03"},
+		{2024, SolarTermSpringEquinox, "2024-03-20"},
+		{2024, SolarTermPureBrightness, "2024-04-04"},
+		{2024, SolarTermSummerSolstice, "2024-06-21"},
+		{2023, SolarTermFrostsDescent, "2023-10-24"},
+		{2021, SolarTermWinterSolstice, "2021-12-21"},
+		{2024, SolarTermWinterSolstice, "2024-12-21"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.term.StringCN(), func(t *testing.T) {
+			if got := SolarTermDate(tt.year, tt.term).String(); got != tt.want {
+				t.Errorf("SolarTermDate() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if s, ok := SolarTermOf(mustDate("2024-02-04")); !ok || s != SolarTermStartOfSpring {
+		t.Errorf("SolarTermOf() = %v, %t", s, ok)
+	}
+	if _, ok := SolarTermOf(mustDate("2024-02-05")); ok {
+		t.Error("2024-02-05 is not a solar term")
+	}
+}
+
+func TestFestival_DateIn(t *testing.T) {
+	tests := []struct {
+		festival Festival
+		year     int
+		want     string
+	}{
+		{FestivalSpring, 2025, "2025-01-29"},
+		{FestivalNewYearsEve, 2025, "2025-01-28"},
+		{FestivalLaba, 2025, "2025-01-07"},
+		{FestivalLantern, 2024, "2024-02-24"},
+		{FestivalQingming, 2024, "2024-04-04"},
+		{FestivalDragonBoat, 2024, "2024-06-10"},
+		{FestivalDragonBoat, 2025, "2025-05-31"},
+		{FestivalMidAutumn, 2023, "2023-09-29"},
+		{FestivalMidAutumn, 2025, "2025-10-06"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.festival.String(), func(t *testing.T) {
+			got, err := tt.festival.DateIn(tt.year)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("Festival.DateIn() = %s, want %s", got, tt.want)
+			}
+
+			f, ok := FestivalOf(got)
+			if !ok || f != tt.festival {
+				t.Errorf("FestivalOf() = %v, %t", f, ok)
+			}
+		})
+	}
+}
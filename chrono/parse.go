@@ -1,19 +1,29 @@
 package chrono
 
 import (
+	"encoding"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
 // ParseDateTime parses SQL DATE or DATETIME string in specified location.
+// DATETIME could have up to 9 digits of fractional seconds.
 func ParseDateTime(str string, loc *time.Location) (t time.Time, err error) {
-	base := "0000-00-00 00:00:00.0000000"
-	switch len(str) {
-	case 10, 19: // up to "YYYY-MM-DD HH:MM:SS"
+	base := "0000-00-00 00:00:00.000000000"
+	switch n := len(str); {
+	case n == 10 || n == 19: // up to "YYYY-MM-DD HH:MM:SS"
 		if str == base[:len(str)] {
 			return
 		}
 		t, err = time.Parse(SQLDateTime[:len(str)], str)
+	case n > 20 && n <= len(base) && str[19] == '.': // "YYYY-MM-DD HH:MM:SS.ffffff"
+		if str == base[:len(str)] {
+			return
+		}
+		t, err = time.Parse(SQLDateTimeFrac, str)
 	default:
 		err = fmt.Errorf("invalid time string: %s", str)
 		return
@@ -27,4 +37,239 @@ func ParseDateTime(str string, loc *time.Location) (t time.Time, err error) {
 	}
 
 	return
-}
\ No newline at end of file
+}
+
+// Layouts recognized by Parse besides those of the time package.
+const (
+	SQLDateTimeFrac  = "2006-01-02 15:04:05.999999999" // SQL DATETIME with optional fractional seconds.
+	ISODateTimeLocal = "2006-01-02T15:04:05.999999999" // ISO 8601 without time zone.
+	CompactDateTime  = "20060102150405"                // Used by Wechat Pay.
+	CompactDate      = "20060102"
+	AppleDateTime    = "2006-01-02 15:04:05 Etc/GMT" // Used in Apple receipts.
+	LayoutUnix       = "unix"                        // 10 digits of seconds since Unix epoch.
+	LayoutUnixMilli  = "unix_milli"                  // 13 digits of milliseconds since Unix epoch.
+)
+
+// StrictLayouts are accepted by ParseStrict, in the order they are tried.
+var StrictLayouts = []string{
+	time.RFC3339Nano,
+	SQLDateTimeFrac,
+	SQLDate,
+}
+
+// LenientLayouts are accepted by ParseLenient, in the order they are tried.
+var LenientLayouts = []string{
+	time.RFC3339Nano,
+	SQLDateTimeFrac,
+	SQLDate,
+	ISODateTimeLocal,
+	AppleDateTime,
+	CompactDateTime,
+	CompactDate,
+	LayoutUnix,
+	LayoutUnixMilli,
+}
+
+// ParseMode decides which layouts Parse accepts.
+type ParseMode int
+
+// Allowed values of ParseMode.
+const (
+	// ParseStrict accepts only StrictLayouts, with no surrounding spaces.
+	ParseStrict ParseMode = iota
+	// ParseLenient accepts LenientLayouts and trims spaces.
+	ParseLenient
+)
+
+var parseModeNames = [...]string{
+	"strict",
+	"lenient",
+}
+
+func (m ParseMode) String() string {
+	if m < ParseStrict || m > ParseLenient {
+		return ""
+	}
+
+	return parseModeNames[m]
+}
+
+// ParseParseMode parses a string like "lenient" into ParseMode.
+func ParseParseMode(s string) (ParseMode, error) {
+	for i, name := range parseModeNames {
+		if name == s {
+			return ParseMode(i), nil
+		}
+	}
+
+	return ParseStrict, fmt.Errorf("%s is not a valid ParseMode", s)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m ParseMode) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (m *ParseMode) UnmarshalText(text []byte) error {
+	tmp, err := ParseParseMode(string(text))
+	if err != nil {
+		return err
+	}
+
+	*m = tmp
+
+	return nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseLayout parses str with a single layout.
+func parseLayout(str string, layout string, loc *time.Location) (time.Time, error) {
+	switch layout {
+	case LayoutUnix, LayoutUnixMilli:
+		want := 10
+		if layout == LayoutUnixMilli {
+			want = 13
+		}
+		if len(str) != want || !isDigits(str) {
+			return time.Time{}, fmt.Errorf("%s is not %s", str, layout)
+		}
+
+		n, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if layout == LayoutUnixMilli {
+			return time.UnixMilli(n).In(loc), nil
+		}
+
+		return time.Unix(n, 0).In(loc), nil
+
+	case AppleDateTime:
+		// The zone name is a literal in layout, so it is always UTC.
+		return time.ParseInLocation(layout, str, time.UTC)
+
+	default:
+		return time.ParseInLocation(layout, str, loc)
+	}
+}
+
+// Parse parses a time string by trying the layouts of mode in order,
+// and reports the layout matched.
+// Layouts without time zone are parsed in loc, which defaults to UTC if nil.
+// SQL zero value like 0000-00-00 is parsed to zero time.
+func Parse(str string, mode ParseMode, loc *time.Location) (time.Time, string, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	layouts := StrictLayouts
+	if mode == ParseLenient {
+		layouts = LenientLayouts
+		str = strings.TrimSpace(str)
+	}
+
+	if strings.HasPrefix(str, "0000-00-00") {
+		if len(str) == len(SQLDate) {
+			return time.Time{}, SQLDate, nil
+		}
+		if strings.Trim(str[len(SQLDate):], " 0:.") == "" {
+			return time.Time{}, SQLDateTimeFrac, nil
+		}
+	}
+
+	for _, layout := range layouts {
+		t, err := parseLayout(str, layout, loc)
+		if err == nil {
+			return t, layout, nil
+		}
+	}
+
+	return time.Time{}, "", fmt.Errorf("invalid time string: %s", str)
+}
+
+// parseJSON parses the JSON value of a time.
+// Null and empty string are turned into zero value;
+// numbers are treated as Unix epoch in seconds or milliseconds.
+func parseJSON(data []byte) (time.Time, error) {
+	s := string(data)
+	if s == "null" {
+		return time.Time{}, nil
+	}
+
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	} else if !isDigits(s) {
+		return time.Time{}, fmt.Errorf("invalid JSON time: %s", data)
+	}
+
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	t, _, err := Parse(s, ParseLenient, time.UTC)
+
+	return t, err
+}
+
+// jsonToText converts the JSON of a string or number to text.
+// Null is turned into empty text.
+func jsonToText(data []byte, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	if string(data) == "null" {
+		return []byte{}, nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return nil, err
+		}
+		return []byte(s), nil
+	}
+
+	return data, nil
+}
+
+// appendText implements AppendText for types embedding time.Time.
+// The AppendText promoted from time.Time takes precedence over
+// MarshalText in encoding/json, e.g., for map keys,
+// so each of those types overrides it with this function
+// to keep its own text format.
+func appendText(b []byte, m encoding.TextMarshaler) ([]byte, error) {
+	text, err := m.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, text...), nil
+}
+
+// textToJSON quotes text as a JSON string.
+// Empty text is turned into null.
+func textToJSON(text []byte) []byte {
+	if len(text) == 0 {
+		return []byte("null")
+	}
+
+	b, _ := json.Marshal(string(text))
+
+	return b
+}
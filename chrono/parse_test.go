@@ -21,3 +21,122 @@ func TestParseDateTime(t *testing.T) {
 
 	t.Logf("ParseDateTime %v", result)
 }
+
+func TestParse(t *testing.T) {
+	want := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		str        string
+		mode       ParseMode
+		want       time.Time
+		wantLayout string
+		wantErr    bool
+	}{
+		{
+			name:       "RFC 3339 with millis",
+			str:        "2021-03-04T13:06:07.123+08:00",
+			mode:       ParseStrict,
+			want:       want.Add(123 * time.Millisecond),
+			wantLayout: time.RFC3339Nano,
+		},
+		{
+			name:       "SQL with fraction",
+			str:        "2021-03-04 05:06:07.000123",
+			mode:       ParseStrict,
+			want:       want.Add(123 * time.Microsecond),
+			wantLayout: SQLDateTimeFrac,
+		},
+		{
+			name:       "SQL zero",
+			str:        "0000-00-00 00:00:00",
+			mode:       ParseStrict,
+			want:       time.Time{},
+			wantLayout: SQLDateTimeFrac,
+		},
+		{
+			name:    "Compact in strict mode",
+			str:     "20210304050607",
+			mode:    ParseStrict,
+			wantErr: true,
+		},
+		{
+			name:       "Compact",
+			str:        "20210304050607",
+			mode:       ParseLenient,
+			want:       want,
+			wantLayout: CompactDateTime,
+		},
+		{
+			name:       "Apple",
+			str:        " 2021-03-04 05:06:07 Etc/GMT ",
+			mode:       ParseLenient,
+			want:       want,
+			wantLayout: AppleDateTime,
+		},
+		{
+			name:       "Unix seconds",
+			str:        "1614834367",
+			mode:       ParseLenient,
+			want:       want,
+			wantLayout: LayoutUnix,
+		},
+		{
+			name:       "Unix millis",
+			str:        "1614834367123",
+			mode:       ParseLenient,
+			want:       want.Add(123 * time.Millisecond),
+			wantLayout: LayoutUnixMilli,
+		},
+		{
+			name:    "Garbage",
+			str:     "yesterday",
+			mode:    ParseLenient,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, layout, err := Parse(tt.str, tt.mode, time.UTC)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+			if layout != tt.wantLayout {
+				t.Errorf("Parse() layout = %s, want %s", layout, tt.wantLayout)
+			}
+		})
+	}
+}
+
+func TestTime_UnmarshalJSON_Lenient(t *testing.T) {
+	want := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	for _, data := range []string{
+		`"2021-03-04T05:06:07Z"`,
+		`"2021-03-04 05:06:07"`,
+		`"20210304050607"`,
+		`1614834367`,
+		`"1614834367000"`,
+	} {
+		var got Time
+		if err := got.UnmarshalJSON([]byte(data)); err != nil {
+			t.Errorf("Time.UnmarshalJSON(%s) error = %v", data, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("Time.UnmarshalJSON(%s) = %v", data, got)
+		}
+	}
+
+	var empty Time
+	if err := empty.UnmarshalJSON([]byte(`""`)); err != nil || !empty.IsZero() {
+		t.Errorf("Time.UnmarshalJSON(empty) = %v, %v", empty, err)
+	}
+}
@@ -0,0 +1,479 @@
+package chrono
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrEndBeforeStart is returned when creating a Period whose end is before start.
+var ErrEndBeforeStart = errors.New("chrono: end is before start")
+
+// Bounds tells whether the start and end of a Period are included.
+// The zero value includes start and excludes end.
+type Bounds int
+
+// Allowed values of Bounds
+const (
+	BoundsClosedOpen Bounds = iota // [start, end)
+	BoundsClosed                   // [start, end]
+	BoundsOpen                     // (start, end)
+	BoundsOpenClosed               // (start, end]
+)
+
+var boundsNames = [...]string{
+	"[)",
+	"[]",
+	"()",
+	"(]",
+}
+
+// ParseBounds parses a string like "[)" into Bounds.
+func ParseBounds(s string) (Bounds, error) {
+	for i, name := range boundsNames {
+		if name == s {
+			return Bounds(i), nil
+		}
+	}
+
+	return BoundsClosedOpen, fmt.Errorf("%s is not a valid Bounds", s)
+}
+
+func boundsOf(startIncl, endIncl bool) Bounds {
+	switch {
+	case startIncl && endIncl:
+		return BoundsClosed
+	case startIncl:
+		return BoundsClosedOpen
+	case endIncl:
+		return BoundsOpenClosed
+	default:
+		return BoundsOpen
+	}
+}
+
+func (b Bounds) String() string {
+	if b < BoundsClosedOpen || b > BoundsOpenClosed {
+		return ""
+	}
+
+	return boundsNames[b]
+}
+
+// StartInclusive tests whether start is included.
+func (b Bounds) StartInclusive() bool {
+	return b == BoundsClosedOpen || b == BoundsClosed
+}
+
+// EndInclusive tests whether end is included.
+func (b Bounds) EndInclusive() bool {
+	return b == BoundsClosed || b == BoundsOpenClosed
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (b Bounds) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.String())
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+// Null is turned into the default BoundsClosedOpen.
+func (b *Bounds) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if s == nil {
+		*b = BoundsClosedOpen
+		return nil
+	}
+
+	tmp, err := ParseBounds(*s)
+	if err != nil {
+		return err
+	}
+
+	*b = tmp
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (b Bounds) MarshalText() ([]byte, error) {
+	return jsonToText(b.MarshalJSON())
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into the default BoundsClosedOpen.
+func (b *Bounds) UnmarshalText(text []byte) error {
+	return b.UnmarshalJSON(textToJSON(text))
+}
+
+// Period is a range of time.
+// To save it in two SQL columns, scan and save Start and End separately;
+// the Period itself is saved in a single string column like
+// [2021-01-01T00:00:00Z,2021-02-01T00:00:00Z)
+type Period struct {
+	Start  Time   `json:"start"`
+	End    Time   `json:"end"`
+	Bounds Bounds `json:"bounds"`
+}
+
+// NewPeriod creates a new Period, rejecting end before start.
+func NewPeriod(start, end time.Time, b Bounds) (Period, error) {
+	p := Period{
+		Start:  TimeFrom(start),
+		End:    TimeFrom(end),
+		Bounds: b,
+	}
+
+	if err := p.Validate(); err != nil {
+		return Period{}, err
+	}
+
+	return p, nil
+}
+
+// DatePeriod creates a Period covering whole days from start to end, both inclusive.
+// The Period is [start, end + 1 day) with the days read in UTC.
+func DatePeriod(start, end Date) (Period, error) {
+	return NewPeriod(dateOf(start.civil()).Time, end.AddDays(1).Time, BoundsClosedOpen)
+}
+
+// Validate checks that end is not before start.
+func (p Period) Validate() error {
+	if p.End.Before(p.Start.Time) {
+		return ErrEndBeforeStart
+	}
+
+	return nil
+}
+
+// IsZero tests whether both start and end are zero.
+func (p Period) IsZero() bool {
+	return p.Start.IsZero() && p.End.IsZero()
+}
+
+// IsEmpty tests whether the Period contains no instant at all.
+func (p Period) IsEmpty() bool {
+	if p.End.Before(p.Start.Time) {
+		return true
+	}
+
+	return p.Start.Equal(p.End.Time) && p.Bounds != BoundsClosed
+}
+
+// Contains tests whether t falls into the Period.
+func (p Period) Contains(t time.Time) bool {
+	if t.Before(p.Start.Time) || t.After(p.End.Time) {
+		return false
+	}
+
+	if t.Equal(p.Start.Time) && !p.Bounds.StartInclusive() {
+		return false
+	}
+
+	if t.Equal(p.End.Time) && !p.Bounds.EndInclusive() {
+		return false
+	}
+
+	return true
+}
+
+// endsBefore tests whether a range ending at end finishes
+// before another range starting at start begins.
+func endsBefore(end time.Time, endIncl bool, start time.Time, startIncl bool) bool {
+	if end.Before(start) {
+		return true
+	}
+
+	return end.Equal(start) && !(endIncl && startIncl)
+}
+
+// Overlaps tests whether two periods share any instant.
+func (p Period) Overlaps(other Period) bool {
+	if p.IsEmpty() || other.IsEmpty() {
+		return false
+	}
+
+	return !endsBefore(p.End.Time, p.Bounds.EndInclusive(), other.Start.Time, other.Bounds.StartInclusive()) &&
+		!endsBefore(other.End.Time, other.Bounds.EndInclusive(), p.Start.Time, p.Bounds.StartInclusive())
+}
+
+// Intersect returns the instants shared by two periods.
+// The second value is false if they do not overlap.
+func (p Period) Intersect(other Period) (Period, bool) {
+	if !p.Overlaps(other) {
+		return Period{}, false
+	}
+
+	start, startIncl := p.Start, p.Bounds.StartInclusive()
+	switch {
+	case other.Start.After(start.Time):
+		start, startIncl = other.Start, other.Bounds.StartInclusive()
+	case other.Start.Equal(start.Time):
+		startIncl = startIncl && other.Bounds.StartInclusive()
+	}
+
+	end, endIncl := p.End, p.Bounds.EndInclusive()
+	switch {
+	case other.End.Before(end.Time):
+		end, endIncl = other.End, other.Bounds.EndInclusive()
+	case other.End.Equal(end.Time):
+		endIncl = endIncl && other.Bounds.EndInclusive()
+	}
+
+	return Period{
+		Start:  start,
+		End:    end,
+		Bounds: boundsOf(startIncl, endIncl),
+	}, true
+}
+
+// adjacent tests whether p ends exactly where other starts without gap.
+func (p Period) adjacent(other Period) bool {
+	return p.End.Equal(other.Start.Time) &&
+		(p.Bounds.EndInclusive() || other.Bounds.StartInclusive())
+}
+
+// Union merges two periods which overlap or are next to each other.
+// The second value is false if there is a gap between them.
+func (p Period) Union(other Period) (Period, bool) {
+	if !p.Overlaps(other) && !p.adjacent(other) && !other.adjacent(p) {
+		return Period{}, false
+	}
+
+	start, startIncl := p.Start, p.Bounds.StartInclusive()
+	switch {
+	case other.Start.Before(start.Time):
+		start, startIncl = other.Start, other.Bounds.StartInclusive()
+	case other.Start.Equal(start.Time):
+		startIncl = startIncl || other.Bounds.StartInclusive()
+	}
+
+	end, endIncl := p.End, p.Bounds.EndInclusive()
+	switch {
+	case other.End.After(end.Time):
+		end, endIncl = other.End, other.Bounds.EndInclusive()
+	case other.End.Equal(end.Time):
+		endIncl = endIncl || other.Bounds.EndInclusive()
+	}
+
+	return Period{
+		Start:  start,
+		End:    end,
+		Bounds: boundsOf(startIncl, endIncl),
+	}, true
+}
+
+// Duration returns the length of the Period.
+func (p Period) Duration() time.Duration {
+	return p.End.Sub(p.Start.Time)
+}
+
+// Days returns the number of whole 24-hour days in the Period.
+func (p Period) Days() int {
+	return int(p.Duration() / (24 * time.Hour))
+}
+
+// Split cuts the Period into contiguous pieces at the boundaries of
+// calendar units of g in loc.
+// Weeks start on Monday.
+// The first and last pieces are clipped to the Period.
+func (p Period) Split(g Granularity, loc *time.Location) []Period {
+	var pieces []Period
+	if p.IsEmpty() {
+		return pieces
+	}
+
+	start := p.Start.Time
+	startIncl := p.Bounds.StartInclusive()
+	for {
+		next := g.next(g.truncate(start, loc))
+		if !next.Before(p.End.Time) {
+			pieces = append(pieces, Period{
+				Start:  TimeFrom(start),
+				End:    p.End,
+				Bounds: boundsOf(startIncl, p.Bounds.EndInclusive()),
+			})
+			return pieces
+		}
+
+		pieces = append(pieces, Period{
+			Start:  TimeFrom(start),
+			End:    TimeFrom(next),
+			Bounds: boundsOf(startIncl, false),
+		})
+
+		start, startIncl = next, true
+	}
+}
+
+// String produces the format of [start,end) in RFC 3339.
+func (p Period) String() string {
+	b := p.Bounds.String()
+	if b == "" {
+		return ""
+	}
+
+	return b[:1] +
+		p.Start.In(time.UTC).Format(time.RFC3339Nano) +
+		"," +
+		p.End.In(time.UTC).Format(time.RFC3339Nano) +
+		b[1:]
+}
+
+// ParsePeriod parses the output of Period.String.
+func ParsePeriod(s string) (Period, error) {
+	if len(s) < 3 {
+		return Period{}, fmt.Errorf("invalid period: %s", s)
+	}
+
+	b, err := ParseBounds(s[:1] + s[len(s)-1:])
+	if err != nil {
+		return Period{}, err
+	}
+
+	start, end, ok := strings.Cut(s[1:len(s)-1], ",")
+	if !ok {
+		return Period{}, fmt.Errorf("invalid period: %s", s)
+	}
+
+	st, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(start))
+	if err != nil {
+		return Period{}, err
+	}
+
+	et, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(end))
+	if err != nil {
+		return Period{}, err
+	}
+
+	return NewPeriod(st, et, b)
+}
+
+// MarshalJSON implements the Marshaler interface.
+// It keeps the object form of start, end and bounds,
+// which would otherwise be replaced by the output of MarshalText.
+func (p Period) MarshalJSON() ([]byte, error) {
+	type period Period
+
+	return json.Marshal(period(p))
+}
+
+// UnmarshalJSON implements the Unmarshaler interface
+// and rejects end before start.
+func (p *Period) UnmarshalJSON(data []byte) error {
+	type period Period
+	var tmp period
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	if err := Period(tmp).Validate(); err != nil {
+		return err
+	}
+
+	*p = Period(tmp)
+
+	return nil
+}
+
+// MarshalText produces the format of Period.String.
+// Zero value produces empty text.
+func (p Period) MarshalText() ([]byte, error) {
+	if p.IsZero() {
+		return []byte{}, nil
+	}
+
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText parses the format of Period.String.
+// Empty text is turned into zero value.
+func (p *Period) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*p = Period{}
+		return nil
+	}
+
+	*p, err = ParsePeriod(string(text))
+	return
+}
+
+// Scan implements the Scanner interface.
+// SQL NULL will be turned into zero value.
+func (p *Period) Scan(value interface{}) (err error) {
+	switch v := value.(type) {
+	case nil:
+		*p = Period{}
+		return nil
+	case []byte:
+		*p, err = ParsePeriod(string(v))
+		return
+	case string:
+		*p, err = ParsePeriod(v)
+		return
+	}
+
+	return fmt.Errorf("can't convert %T to Period", value)
+}
+
+// Value implements the driver Valuer interface.
+// Zero value is turned into SQL NULL.
+func (p Period) Value() (driver.Value, error) {
+	if p.IsZero() {
+		return nil, nil
+	}
+
+	return p.String(), nil
+}
+
+// parseQueryTime parses a date or date time string.
+// Date is parsed to midnight in loc.
+func parseQueryTime(s string, loc *time.Location) (t time.Time, isDate bool, err error) {
+	if len(s) == len(SQLDate) {
+		t, err = time.ParseInLocation(SQLDate, s, loc)
+		return t, true, err
+	}
+
+	t, err = time.Parse(time.RFC3339, s)
+	return t, false, err
+}
+
+// ParsePeriodQuery parses the query parameters `?start=&end=`.
+// Each could be a date like 2021-01-01 or RFC 3339 date time.
+// Dates are read in loc and the end date is included as a whole day.
+// The Period returned always includes start and excludes end.
+func ParsePeriodQuery(query url.Values, loc *time.Location) (Period, error) {
+	start := strings.TrimSpace(query.Get("start"))
+	end := strings.TrimSpace(query.Get("end"))
+	if start == "" || end == "" {
+		return Period{}, errors.New("both start and end are required")
+	}
+
+	st, _, err := parseQueryTime(start, loc)
+	if err != nil {
+		return Period{}, err
+	}
+
+	et, isDate, err := parseQueryTime(end, loc)
+	if err != nil {
+		return Period{}, err
+	}
+
+	// Check before extending end date to next day.
+	if et.Before(st) {
+		return Period{}, ErrEndBeforeStart
+	}
+
+	if isDate {
+		et = et.AddDate(0, 0, 1)
+	}
+
+	return NewPeriod(st, et, BoundsClosedOpen)
+}
@@ -0,0 +1,217 @@
+package chrono
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func mustPeriod(s string) Period {
+	p, err := ParsePeriod(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+func TestNewPeriod(t *testing.T) {
+	now := time.Now()
+
+	if _, err := NewPeriod(now, now.Add(-time.Second), BoundsClosedOpen); err != ErrEndBeforeStart {
+		t.Errorf("NewPeriod() error = %v, want %v", err, ErrEndBeforeStart)
+	}
+
+	var p Period
+	err := json.Unmarshal([]byte(`{"start":"2021-02-01T00:00:00Z","end":"2021-01-01T00:00:00Z"}`), &p)
+	if err != ErrEndBeforeStart {
+		t.Errorf("Period.UnmarshalJSON() error = %v, want %v", err, ErrEndBeforeStart)
+	}
+}
+
+func TestPeriod_Contains(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		bounds   Bounds
+		at       time.Time
+		contains bool
+	}{
+		{BoundsClosedOpen, start, true},
+		{BoundsClosedOpen, end, false},
+		{BoundsClosed, end, true},
+		{BoundsOpen, start, false},
+		{BoundsOpenClosed, end, true},
+		{BoundsClosed, end.Add(time.Nanosecond), false},
+	}
+	for _, tt := range tests {
+		p, _ := NewPeriod(start, end, tt.bounds)
+		if got := p.Contains(tt.at); got != tt.contains {
+			t.Errorf("%s.Contains(%s) = %t, want %t", p, tt.at, got, tt.contains)
+		}
+	}
+}
+
+func TestPeriod_Overlaps(t *testing.T) {
+	tests := []struct {
+		a         string
+		b         string
+		overlaps  bool
+		intersect string
+		union     string
+	}{
+		{
+			a:         "[2021-01-01T00:00:00Z,2021-01-10T00:00:00Z)",
+			b:         "[2021-01-05T00:00:00Z,2021-01-20T00:00:00Z]",
+			overlaps:  true,
+			intersect: "[2021-01-05T00:00:00Z,2021-01-10T00:00:00Z)",
+			union:     "[2021-01-01T00:00:00Z,2021-01-20T00:00:00Z]",
+		},
+		{
+			a:        "[2021-01-01T00:00:00Z,2021-01-10T00:00:00Z)",
+			b:        "[2021-01-10T00:00:00Z,2021-01-20T00:00:00Z)",
+			overlaps: false,
+			union:    "[2021-01-01T00:00:00Z,2021-01-20T00:00:00Z)",
+		},
+		{
+			a:         "[2021-01-01T00:00:00Z,2021-01-10T00:00:00Z]",
+			b:         "[2021-01-10T00:00:00Z,2021-01-20T00:00:00Z)",
+			overlaps:  true,
+			intersect: "[2021-01-10T00:00:00Z,2021-01-10T00:00:00Z]",
+			union:     "[2021-01-01T00:00:00Z,2021-01-20T00:00:00Z)",
+		},
+		{
+			a:        "[2021-01-01T00:00:00Z,2021-01-10T00:00:00Z)",
+			b:        "(2021-01-10T00:00:00Z,2021-01-20T00:00:00Z)",
+			overlaps: false,
+		},
+	}
+	for _, tt := range tests {
+		a, b := mustPeriod(tt.a), mustPeriod(tt.b)
+
+		if got := a.Overlaps(b); got != tt.overlaps {
+			t.Errorf("%s.Overlaps(%s) = %t", a, b, got)
+		}
+
+		got, ok := a.Intersect(b)
+		if ok != (tt.intersect != "") || (ok && got.String() != tt.intersect) {
+			t.Errorf("%s.Intersect(%s) = %s, %t", a, b, got, ok)
+		}
+
+		got, ok = a.Union(b)
+		if ok != (tt.union != "") || (ok && got.String() != tt.union) {
+			t.Errorf("%s.Union(%s) = %s, %t", a, b, got, ok)
+		}
+	}
+}
+
+func TestPeriod_Split(t *testing.T) {
+	// Wednesday noon to next Wednesday noon in Shanghai.
+	p, _ := NewPeriod(
+		time.Date(2021, 1, 27, 12, 0, 0, 0, TZShanghai),
+		time.Date(2021, 2, 3, 12, 0, 0, 0, TZShanghai),
+		BoundsClosedOpen)
+
+	tests := []struct {
+		g    Granularity
+		want int
+	}{
+		{GranularityDay, 8},
+		{GranularityWeek, 2},
+		{GranularityMonth, 2},
+	}
+	for _, tt := range tests {
+		pieces := p.Split(tt.g, TZShanghai)
+		if len(pieces) != tt.want {
+			t.Errorf("Period.Split(%s) = %d pieces, want %d", tt.g, len(pieces), tt.want)
+			continue
+		}
+
+		if !pieces[0].Start.Equal(p.Start.Time) || !pieces[len(pieces)-1].End.Equal(p.End.Time) {
+			t.Errorf("Period.Split(%s) not clipped: %v", tt.g, pieces)
+		}
+
+		for i := 1; i < len(pieces); i++ {
+			if !pieces[i-1].End.Equal(pieces[i].Start.Time) {
+				t.Errorf("Period.Split(%s) not contiguous: %v", tt.g, pieces)
+			}
+		}
+	}
+
+	weeks := p.Split(GranularityWeek, TZShanghai)
+	if got := weeks[1].Start.In(TZShanghai); got.Weekday() != time.Monday || got.Hour() != 0 {
+		t.Errorf("Week starts at %s", got)
+	}
+}
+
+func TestPeriod_SQL(t *testing.T) {
+	p := mustPeriod("(2021-01-01T00:00:00Z,2021-01-10T08:30:00.5Z]")
+
+	v, err := p.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Period
+	if err := got.Scan([]byte(v.(string))); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.String() != p.String() {
+		t.Errorf("Period.Scan() = %s, want %s", got, p)
+	}
+}
+
+func TestPeriod_JSON(t *testing.T) {
+	p := mustPeriod("(2021-01-01T00:00:00Z,2021-01-10T08:30:00Z]")
+
+	b, err := json.Marshal([]Period{p})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `[{"start":"2021-01-01T00:00:00Z","end":"2021-01-10T08:30:00Z","bounds":"(]"}]`
+	if string(b) != want {
+		t.Errorf("Period.MarshalJSON() = %s, want %s", b, want)
+	}
+
+	var got []Period
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 1 || got[0].String() != p.String() {
+		t.Errorf("Period.UnmarshalJSON() = %v, want %s", got, p)
+	}
+}
+
+func TestParsePeriodQuery(t *testing.T) {
+	q, _ := url.ParseQuery("start=2021-01-01&end=2021-01-31")
+
+	p, err := ParsePeriodQuery(q, TZShanghai)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := p.String(); got != "[2020-12-31T16:00:00Z,2021-01-31T16:00:00Z)" {
+		t.Errorf("ParsePeriodQuery() = %s", got)
+	}
+
+	q, _ = url.ParseQuery("start=2021-02-01&end=2021-01-31")
+	if _, err := ParsePeriodQuery(q, TZShanghai); err != ErrEndBeforeStart {
+		t.Errorf("ParsePeriodQuery() error = %v", err)
+	}
+}
+
+func TestDatePeriod(t *testing.T) {
+	p, err := DatePeriod(mustDate("2021-01-01"), mustDate("2021-01-31"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Days() != 31 {
+		t.Errorf("Period.Days() = %d, want 31", p.Days())
+	}
+}
@@ -0,0 +1,283 @@
+package chrono
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Precision is the fraction of seconds kept when Time is
+// saved to SQL or marshalled to JSON.
+type Precision int
+
+// Allowed values of Precision
+const (
+	PrecisionSecond Precision = iota // DATETIME
+	PrecisionMilli                   // DATETIME(3)
+	PrecisionMicro                   // DATETIME(6)
+)
+
+var precisionNames = [...]string{
+	"second",
+	"milli",
+	"micro",
+}
+
+// Layouts with fixed fractional seconds.
+const (
+	SQLDateTimeMilli = "2006-01-02 15:04:05.000"
+	SQLDateTimeMicro = "2006-01-02 15:04:05.000000"
+	RFC3339Milli     = "2006-01-02T15:04:05.000Z07:00"
+	RFC3339Micro     = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// DefaultPrecision is used by Time.
+// It defaults to PrecisionSecond so that existing DATETIME columns keep working.
+// Change it only once at start-up; use TimeMilli or TimeMicro
+// if only some columns have fractional seconds.
+var DefaultPrecision = PrecisionSecond
+
+func (p Precision) String() string {
+	if p < PrecisionSecond || p > PrecisionMicro {
+		return ""
+	}
+
+	return precisionNames[p]
+}
+
+// ParsePrecision parses a string like "milli" into Precision.
+func ParsePrecision(s string) (Precision, error) {
+	for i, name := range precisionNames {
+		if name == s {
+			return Precision(i), nil
+		}
+	}
+
+	return PrecisionSecond, fmt.Errorf("%s is not a valid Precision", s)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (p Precision) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (p *Precision) UnmarshalText(text []byte) error {
+	tmp, err := ParsePrecision(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = tmp
+
+	return nil
+}
+
+// Unit returns the smallest duration kept.
+func (p Precision) Unit() time.Duration {
+	switch p {
+	case PrecisionMilli:
+		return time.Millisecond
+	case PrecisionMicro:
+		return time.Microsecond
+	default:
+		return time.Second
+	}
+}
+
+// Truncate drops the fraction of seconds beyond the precision.
+func (p Precision) Truncate(t time.Time) time.Time {
+	return t.Truncate(p.Unit())
+}
+
+// SQLLayout returns the layout of SQL DATETIME with the precision.
+func (p Precision) SQLLayout() string {
+	switch p {
+	case PrecisionMilli:
+		return SQLDateTimeMilli
+	case PrecisionMicro:
+		return SQLDateTimeMicro
+	default:
+		return SQLDateTime
+	}
+}
+
+// JSONLayout returns the layout of RFC 3339 with the precision.
+func (p Precision) JSONLayout() string {
+	switch p {
+	case PrecisionMilli:
+		return RFC3339Milli
+	case PrecisionMicro:
+		return RFC3339Micro
+	default:
+		return time.RFC3339
+	}
+}
+
+// TimeUTCNowPrecise creates a Time of now in UTC truncated to p.
+func TimeUTCNowPrecise(p Precision) Time {
+	return Time{p.Truncate(now()).UTC()}
+}
+
+// marshalTime produces the JSON of a time in UTC with precision p.
+// Zero value is turned into null.
+func marshalTime(t time.Time, p Precision) ([]byte, error) {
+	if y := t.Year(); y < 0 || y >= 10000 {
+		return nil, errors.New("Time.MarshalJSON: year outside of range [0,9999]")
+	}
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	layout := p.JSONLayout()
+
+	b := make([]byte, 0, len(layout)+2)
+	b = append(b, '"')
+	b = t.In(time.UTC).AppendFormat(b, layout)
+	b = append(b, '"')
+	return b, nil
+}
+
+// scanTime reads SQL DATETIME of any precision.
+// Strings are parsed with ParseLenient in UTC.
+func scanTime(value interface{}) (t time.Time, err error) {
+	switch v := value.(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return v, nil
+	case []byte:
+		t, _, err = Parse(string(v), ParseLenient, time.UTC)
+		return
+	case string:
+		t, _, err = Parse(v, ParseLenient, time.UTC)
+		return
+	}
+
+	return time.Time{}, fmt.Errorf("can't convert %T to time.Time", value)
+}
+
+// timeValue produces SQL DATETIME in UTC with precision p.
+// Zero value is turned into SQL NULL.
+func timeValue(t time.Time, p Precision) (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+
+	return t.In(time.UTC).Format(p.SQLLayout()), nil
+}
+
+// TimeMilli is a Time saved to DATETIME(3) and
+// marshalled to JSON with milliseconds, regardless of DefaultPrecision.
+type TimeMilli struct {
+	time.Time
+}
+
+// TimeMilliFrom creates a new TimeMilli wrapping time.Time.
+func TimeMilliFrom(t time.Time) TimeMilli {
+	return TimeMilli{t}
+}
+
+// ToTime converts to Time without losing precision.
+func (t TimeMilli) ToTime() Time {
+	return Time{t.Time}
+}
+
+// MarshalJSON produces RFC 3339 with milliseconds.
+func (t TimeMilli) MarshalJSON() ([]byte, error) {
+	return marshalTime(t.Time, PrecisionMilli)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *TimeMilli) UnmarshalJSON(data []byte) (err error) {
+	t.Time, err = parseJSON(data)
+	return
+}
+
+// Scan implements the Scanner interface.
+// SQL NULL will be turned into time zero value.
+func (t *TimeMilli) Scan(value interface{}) (err error) {
+	t.Time, err = scanTime(value)
+	return
+}
+
+// Value produces DATETIME(3).
+// Zero value is turned into SQL NULL.
+func (t TimeMilli) Value() (driver.Value, error) {
+	return timeValue(t.Time, PrecisionMilli)
+}
+
+// TimeMicro is a Time saved to DATETIME(6) and
+// marshalled to JSON with microseconds, regardless of DefaultPrecision.
+type TimeMicro struct {
+	time.Time
+}
+
+// TimeMicroFrom creates a new TimeMicro wrapping time.Time.
+func TimeMicroFrom(t time.Time) TimeMicro {
+	return TimeMicro{t}
+}
+
+// ToTime converts to Time without losing precision.
+func (t TimeMicro) ToTime() Time {
+	return Time{t.Time}
+}
+
+// MarshalJSON produces RFC 3339 with microseconds.
+func (t TimeMicro) MarshalJSON() ([]byte, error) {
+	return marshalTime(t.Time, PrecisionMicro)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *TimeMicro) UnmarshalJSON(data []byte) (err error) {
+	t.Time, err = parseJSON(data)
+	return
+}
+
+// Scan implements the Scanner interface.
+// SQL NULL will be turned into time zero value.
+func (t *TimeMicro) Scan(value interface{}) (err error) {
+	t.Time, err = scanTime(value)
+	return
+}
+
+// Value produces DATETIME(6).
+// Zero value is turned into SQL NULL.
+func (t TimeMicro) Value() (driver.Value, error) {
+	return timeValue(t.Time, PrecisionMicro)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+// with the same output as MarshalJSON, except that null is empty text.
+func (t TimeMilli) MarshalText() ([]byte, error) {
+	return jsonToText(t.MarshalJSON())
+}
+
+// AppendText implements the encoding.TextAppender interface. See appendText.
+func (t TimeMilli) AppendText(b []byte) ([]byte, error) {
+	return appendText(b, t)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (t *TimeMilli) UnmarshalText(text []byte) error {
+	return t.UnmarshalJSON(textToJSON(text))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+// with the same output as MarshalJSON, except that null is empty text.
+func (t TimeMicro) MarshalText() ([]byte, error) {
+	return jsonToText(t.MarshalJSON())
+}
+
+// AppendText implements the encoding.TextAppender interface. See appendText.
+func (t TimeMicro) AppendText(b []byte) ([]byte, error) {
+	return appendText(b, t)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (t *TimeMicro) UnmarshalText(text []byte) error {
+	return t.UnmarshalJSON(textToJSON(text))
+}
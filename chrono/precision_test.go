@@ -0,0 +1,92 @@
+package chrono
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseDateTime_Fraction(t *testing.T) {
+	tests := []struct {
+		str  string
+		want time.Time
+	}{
+		{"2021-03-04 05:06:07.123", time.Date(2021, 3, 4, 5, 6, 7, 123000000, time.UTC)},
+		{"2021-03-04 05:06:07.123456", time.Date(2021, 3, 4, 5, 6, 7, 123456000, time.UTC)},
+		{"0000-00-00 00:00:00.000000", time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			got, err := ParseDateTime(tt.str, time.UTC)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseDateTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParseDateTime("2021-03-04 05:06:07.", time.UTC); err == nil {
+		t.Error("ParseDateTime() should reject empty fraction")
+	}
+}
+
+func TestPrecision_Columns(t *testing.T) {
+	ts := time.Date(2021, 3, 4, 5, 6, 7, 123456789, time.UTC)
+
+	type event struct {
+		Created  Time      `json:"created"`
+		Received TimeMilli `json:"received"`
+		Handled  TimeMicro `json:"handled"`
+	}
+
+	b, err := json.Marshal(event{
+		Created:  TimeFrom(ts),
+		Received: TimeMilliFrom(ts),
+		Handled:  TimeMicroFrom(ts),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"created":"2021-03-04T05:06:07Z","received":"2021-03-04T05:06:07.123Z","handled":"2021-03-04T05:06:07.123456Z"}`
+	if string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+
+	v, _ := TimeMicroFrom(ts).Value()
+	if v != "2021-03-04 05:06:07.123456" {
+		t.Errorf("TimeMicro.Value() = %v", v)
+	}
+
+	var scanned TimeMicro
+	if err := scanned.Scan([]byte(v.(string))); err != nil {
+		t.Fatal(err)
+	}
+	if !scanned.Equal(PrecisionMicro.Truncate(ts)) {
+		t.Errorf("TimeMicro.Scan() = %v", scanned)
+	}
+
+	v, _ = TimeMilliFrom(ts).Value()
+	if v != "2021-03-04 05:06:07.123" {
+		t.Errorf("TimeMilli.Value() = %v", v)
+	}
+
+	v, _ = TimeFrom(ts).Value()
+	if v != "2021-03-04 05:06:07" {
+		t.Errorf("Time.Value() = %v", v)
+	}
+}
+
+func TestTimeUTCNowPrecise(t *testing.T) {
+	fixed := time.Date(2021, 3, 4, 5, 6, 7, 123456789, time.UTC)
+	defer SetClock(NewFakeClock(fixed))()
+
+	if got := TimeUTCNowPrecise(PrecisionMilli); got.Nanosecond() != 123000000 {
+		t.Errorf("TimeUTCNowPrecise() = %v", got)
+	}
+	if got := TimeUTCNow(); got.Nanosecond() != 0 {
+		t.Errorf("TimeUTCNow() = %v", got)
+	}
+}
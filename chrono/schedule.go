@@ -0,0 +1,186 @@
+package chrono
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/FTChinese/go-rest/enum"
+)
+
+// AnchorRule decides the day of month of billing dates
+// when the anchor day does not exist in some months.
+type AnchorRule int
+
+// Allowed values of AnchorRule
+const (
+	// AnchorKeep computes every billing date from the first one,
+	// so that a schedule anchored on Jan 31 bills on Feb 28
+	// and returns to Mar 31, the same as Stripe's billing_cycle_anchor.
+	AnchorKeep AnchorRule = iota
+	// AnchorClamp clamps billing dates to month end when the schedule
+	// is anchored on the last day of a month, so that one anchored
+	// on Feb 28 bills on Mar 31 and Apr 30 rather than the 28th.
+	// Other anchors behave as AnchorKeep.
+	AnchorClamp
+)
+
+var anchorRuleNames = [...]string{
+	"keep",
+	"clamp",
+}
+
+func (r AnchorRule) String() string {
+	if r < AnchorKeep || r > AnchorClamp {
+		return ""
+	}
+
+	return anchorRuleNames[r]
+}
+
+// ParseAnchorRule parses a string like "clamp" into AnchorRule.
+func ParseAnchorRule(s string) (AnchorRule, error) {
+	for i, name := range anchorRuleNames {
+		if name == s {
+			return AnchorRule(i), nil
+		}
+	}
+
+	return AnchorKeep, fmt.Errorf("%s is not a valid AnchorRule", s)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (r AnchorRule) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (r *AnchorRule) UnmarshalText(text []byte) error {
+	tmp, err := ParseAnchorRule(string(text))
+	if err != nil {
+		return err
+	}
+
+	*r = tmp
+
+	return nil
+}
+
+// BillingSchedule generates the billing periods of a subscription.
+// The first paid period starts after the trial, if any.
+// Calendar arithmetic is done in Location with the clock of Start kept,
+// so a subscription starting at 20:00 in Shanghai always renews at 20:00 there.
+type BillingSchedule struct {
+	Start    time.Time
+	Cycle    enum.Cycle
+	Trial    Duration
+	Anchor   AnchorRule
+	Count    int       // The number of paid periods. Zero for no limit.
+	Until    time.Time // Periods starting at or after it are excluded. Zero for no limit.
+	Location *time.Location
+}
+
+func (s BillingSchedule) location() *time.Location {
+	if s.Location == nil {
+		return TZShanghai
+	}
+
+	return s.Location
+}
+
+// Validate checks that the schedule is finite.
+func (s BillingSchedule) Validate() error {
+	if s.Start.IsZero() {
+		return errors.New("billing schedule missing start")
+	}
+
+	if _, err := CycleDuration(s.Cycle); err != nil {
+		return err
+	}
+
+	if s.Count < 0 {
+		return errors.New("billing schedule count must not be negative")
+	}
+
+	if s.Count == 0 && s.Until.IsZero() {
+		return errors.New("billing schedule requires either count or until")
+	}
+
+	return nil
+}
+
+// TrialPeriod returns the trial before the first paid period.
+// The second value is false if there is no trial.
+func (s BillingSchedule) TrialPeriod() (Period, bool) {
+	if s.Trial.IsZero() {
+		return Period{}, false
+	}
+
+	start := s.Start.In(s.location())
+
+	return Period{
+		Start: TimeFrom(start),
+		End:   TimeFrom(s.Trial.AddTo(start)),
+	}, true
+}
+
+// Periods generates the paid periods in order.
+// Each Period includes start and excludes end,
+// and the end of one is the start of the next.
+func (s BillingSchedule) Periods() ([]Period, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	cycle, _ := CycleDuration(s.Cycle)
+
+	anchor := s.Trial.AddTo(s.Start.In(s.location()))
+
+	clamp := s.Anchor == AnchorClamp && anchor.Day() == daysIn(anchor.Year(), anchor.Month())
+
+	var periods []Period
+	start := anchor
+	for i := 1; s.Count == 0 || i <= s.Count; i++ {
+		if !s.Until.IsZero() && !start.Before(s.Until) {
+			break
+		}
+
+		end := cycle.Multiply(i).AddTo(anchor)
+		if clamp {
+			end = toMonthEnd(end)
+		}
+
+		periods = append(periods, Period{
+			Start: TimeFrom(start),
+			End:   TimeFrom(end),
+		})
+
+		start = end
+	}
+
+	return periods, nil
+}
+
+// toMonthEnd moves t to the last day of its month, keeping the clock.
+func toMonthEnd(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	hour, minute, second := t.Clock()
+
+	return time.Date(y, m, daysIn(y, m), hour, minute, second, t.Nanosecond(), t.Location())
+}
+
+// BillingDates returns the start of each paid period,
+// i.e., the dates a customer is charged.
+func (s BillingSchedule) BillingDates() ([]Time, error) {
+	periods, err := s.Periods()
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make([]Time, 0, len(periods))
+	for _, p := range periods {
+		dates = append(dates, p.Start)
+	}
+
+	return dates, nil
+}
@@ -0,0 +1,147 @@
+package chrono
+
+import (
+	"testing"
+	"time"
+
+	"github.com/FTChinese/go-rest/enum"
+)
+
+func TestBillingSchedule_Periods(t *testing.T) {
+	start := time.Date(2021, 1, 31, 20, 0, 0, 0, TZShanghai)
+
+	tests := []struct {
+		name     string
+		schedule BillingSchedule
+		want     []string
+	}{
+		{
+			name: "Keep anchor",
+			schedule: BillingSchedule{
+				Start: start,
+				Cycle: enum.CycleMonth,
+				Count: 4,
+			},
+			want: []string{"2021-01-31", "2021-02-28", "2021-03-31", "2021-04-30", "2021-05-31"},
+		},
+		{
+			name: "Clamp anchor",
+			schedule: BillingSchedule{
+				Start:  start,
+				Cycle:  enum.CycleMonth,
+				Anchor: AnchorClamp,
+				Count:  5,
+			},
+			want: []string{"2021-01-31", "2021-02-28", "2021-03-31", "2021-04-30", "2021-05-31", "2021-06-30"},
+		},
+		{
+			name: "Clamp anchor from February end",
+			schedule: BillingSchedule{
+				Start:  time.Date(2021, 2, 28, 20, 0, 0, 0, TZShanghai),
+				Cycle:  enum.CycleMonth,
+				Anchor: AnchorClamp,
+				Count:  4,
+			},
+			want: []string{"2021-02-28", "2021-03-31", "2021-04-30", "2021-05-31", "2021-06-30"},
+		},
+		{
+			name: "Keep anchor from February end",
+			schedule: BillingSchedule{
+				Start: time.Date(2021, 2, 28, 20, 0, 0, 0, TZShanghai),
+				Cycle: enum.CycleMonth,
+				Count: 4,
+			},
+			want: []string{"2021-02-28", "2021-03-28", "2021-04-28", "2021-05-28", "2021-06-28"},
+		},
+		{
+			name: "Clamp anchor mid-month",
+			schedule: BillingSchedule{
+				Start:  time.Date(2021, 1, 15, 20, 0, 0, 0, TZShanghai),
+				Cycle:  enum.CycleMonth,
+				Anchor: AnchorClamp,
+				Count:  4,
+			},
+			want: []string{"2021-01-15", "2021-02-15", "2021-03-15", "2021-04-15", "2021-05-15"},
+		},
+		{
+			name: "Trial and until",
+			schedule: BillingSchedule{
+				Start: start,
+				Cycle: enum.CycleMonth,
+				Trial: DurationOfDays(7),
+				Until: time.Date(2021, 4, 7, 20, 0, 0, 0, TZShanghai),
+			},
+			want: []string{"2021-02-07", "2021-03-07", "2021-04-07"},
+		},
+		{
+			name: "Yearly from leap day",
+			schedule: BillingSchedule{
+				Start: time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC),
+				Cycle: enum.CycleYear,
+				Count: 4,
+			},
+			want: []string{"2024-02-29", "2025-02-28", "2026-02-28", "2027-02-28", "2028-02-29"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods, err := tt.schedule.Periods()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for i, p := range periods {
+				local := p.Start.In(TZShanghai)
+				if i == 0 {
+					got = append(got, local.Format(SQLDate))
+				}
+				if i > 0 && !p.Start.Equal(periods[i-1].End.Time) {
+					t.Errorf("period %d is not contiguous", i)
+				}
+				got = append(got, p.End.In(TZShanghai).Format(SQLDate))
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("Periods() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Periods() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestBillingSchedule_Location(t *testing.T) {
+	// Renewals keep the wall clock across DST changes.
+	ny := MustLoadZone("America/New_York")
+
+	s := BillingSchedule{
+		Start:    time.Date(2021, 2, 15, 9, 0, 0, 0, ny),
+		Cycle:    enum.CycleMonth,
+		Count:    2,
+		Location: ny,
+	}
+
+	dates, err := s.BillingDates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := dates[1].In(ny).Format(time.RFC3339); got != "2021-03-15T09:00:00-04:00" {
+		t.Errorf("BillingDates()[1] = %s", got)
+	}
+
+	trial, ok := BillingSchedule{Start: s.Start, Trial: DurationOfDays(3)}.TrialPeriod()
+	if !ok || trial.Days() != 3 {
+		t.Errorf("TrialPeriod() = %v, %t", trial, ok)
+	}
+
+	if _, err := (BillingSchedule{Start: s.Start, Cycle: enum.CycleMonth}).Periods(); err == nil {
+		t.Error("Periods() should reject unbounded schedule")
+	}
+}
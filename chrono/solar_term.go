@@ -0,0 +1,331 @@
+package chrono
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// SolarTerm is one of the 24 solar terms, i.e., 节气,
+// ordered as they occur in a Gregorian year.
+type SolarTerm int
+
+// Allowed values of SolarTerm
+const (
+	SolarTermMinorCold SolarTerm = iota
+	SolarTermMajorCold
+	SolarTermStartOfSpring
+	SolarTermRainWater
+	SolarTermAwakeningOfInsects
+	SolarTermSpringEquinox
+	SolarTermPureBrightness
+	SolarTermGrainRain
+	SolarTermStartOfSummer
+	SolarTermGrainBuds
+	SolarTermGrainInEar
+	SolarTermSummerSolstice
+	SolarTermMinorHeat
+	SolarTermMajorHeat
+	SolarTermStartOfAutumn
+	SolarTermEndOfHeat
+	SolarTermWhiteDew
+	SolarTermAutumnEquinox
+	SolarTermColdDew
+	SolarTermFrostsDescent
+	SolarTermStartOfWinter
+	SolarTermMinorSnow
+	SolarTermMajorSnow
+	SolarTermWinterSolstice
+)
+
+var solarTermNames = [...]string{
+	"minor_cold", "major_cold", "start_of_spring", "rain_water", "awakening_of_insects", "spring_equinox",
+	"pure_brightness", "grain_rain", "start_of_summer", "grain_buds", "grain_in_ear", "summer_solstice",
+	"minor_heat", "major_heat", "start_of_autumn", "end_of_heat", "white_dew", "autumn_equinox",
+	"cold_dew", "frosts_descent", "start_of_winter", "minor_snow", "major_snow", "winter_solstice",
+}
+
+var solarTermsCN = [...]string{
+	"小寒", "大寒", "立春", "雨水", "惊蛰", "春分",
+	"清明", "谷雨", "立夏", "小满", "芒种", "夏至",
+	"小暑", "大暑", "立秋", "处暑", "白露", "秋分",
+	"寒露", "霜降", "立冬", "小雪", "大雪", "冬至",
+}
+
+var solarTermsEN = [...]string{
+	"Minor Cold", "Major Cold", "Start of Spring", "Rain Water", "Awakening of Insects", "Spring Equinox",
+	"Pure Brightness", "Grain Rain", "Start of Summer", "Grain Buds", "Grain in Ear", "Summer Solstice",
+	"Minor Heat", "Major Heat", "Start of Autumn", "End of Heat", "White Dew", "Autumn Equinox",
+	"Cold Dew", "Frost's Descent", "Start of Winter", "Minor Snow", "Major Snow", "Winter Solstice",
+}
+
+// StringCN produces the Chinese name like 立春.
+func (s SolarTerm) StringCN() string {
+	if s < SolarTermMinorCold || s > SolarTermWinterSolstice {
+		return ""
+	}
+
+	return solarTermsCN[s]
+}
+
+// StringEN produces the English name like Start of Spring.
+func (s SolarTerm) StringEN() string {
+	if s < SolarTermMinorCold || s > SolarTermWinterSolstice {
+		return ""
+	}
+
+	return solarTermsEN[s]
+}
+
+func (s SolarTerm) String() string {
+	return s.StringEN()
+}
+
+// ParseSolarTerm parses a name like "start_of_spring" into SolarTerm.
+func ParseSolarTerm(name string) (SolarTerm, error) {
+	for i, n := range solarTermNames {
+		if n == name {
+			return SolarTerm(i), nil
+		}
+	}
+
+	return SolarTermMinorCold, fmt.Errorf("%s is not a valid SolarTerm", name)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It produces a name like "start_of_spring" rather than String,
+// which is meant for display.
+func (s SolarTerm) MarshalText() ([]byte, error) {
+	if s < SolarTermMinorCold || s > SolarTermWinterSolstice {
+		return []byte{}, nil
+	}
+
+	return []byte(solarTermNames[s]), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *SolarTerm) UnmarshalText(text []byte) error {
+	tmp, err := ParseSolarTerm(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = tmp
+
+	return nil
+}
+
+// longitude returns the apparent longitude of the sun in degrees
+// at which the term begins.
+func (s SolarTerm) longitude() float64 {
+	return math.Mod(285+15*float64(s), 360)
+}
+
+// unixEpochJD is the Julian day of 1970-01-01T00:00:00Z.
+const unixEpochJD = 2440587.5
+
+func julianDay(t time.Time) float64 {
+	return unixEpochJD + float64(t.UnixNano())/float64(24*time.Hour)
+}
+
+func timeOfJulianDay(jd float64) time.Time {
+	return time.Unix(0, int64((jd-unixEpochJD)*float64(24*time.Hour))).UTC()
+}
+
+// deltaT estimates TT minus UT in seconds with
+// the polynomials of Espenak and Meeus.
+func deltaT(year float64) float64 {
+	switch {
+	case year < 1920:
+		t := year - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case year < 1941:
+		t := year - 1920
+		return 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case year < 1961:
+		t := year - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case year < 1986:
+		t := year - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case year < 2005:
+		t := year - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case year < 2050:
+		t := year - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	default:
+		u := (year - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-year)
+	}
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// vsopTerm is a periodic term A cos(B + C τ) of VSOP87.
+type vsopTerm struct {
+	a, b, c float64
+}
+
+// Truncated VSOP87D series of the heliocentric longitude and radius
+// of the earth, from Meeus, Astronomical Algorithms, appendix III.
+var (
+	earthL = [...][]vsopTerm{
+		{
+			{175347046, 0, 0}, {3341656, 4.6692568, 6283.0758500}, {34894, 4.62610, 12566.15170},
+			{3497, 2.7441, 5753.3849}, {3418, 2.8289, 3.5231}, {3136, 3.6277, 77713.7715},
+			{2676, 4.4181, 7860.4194}, {2343, 6.1352, 3930.2097}, {1324, 0.7425, 11506.7698},
+			{1273, 2.0371, 529.6910}, {1199, 1.1096, 1577.3435}, {990, 5.233, 5884.927},
+			{902, 2.045, 26.298}, {857, 3.508, 398.149}, {780, 1.179, 5223.694},
+			{753, 2.533, 5507.553}, {505, 4.583, 18849.228}, {492, 4.205, 775.523},
+			{357, 2.920, 0.067}, {317, 5.849, 11790.629}, {284, 1.899, 796.298},
+			{271, 0.315, 10977.079}, {243, 0.345, 5486.778}, {206, 4.806, 2544.314},
+			{205, 1.869, 5573.143}, {202, 2.458, 6069.777}, {156, 0.833, 213.299},
+			{132, 3.411, 2942.463}, {126, 1.083, 20.775}, {115, 0.645, 0.980},
+			{103, 0.636, 4694.003}, {102, 0.976, 15720.839}, {102, 4.267, 7.114},
+			{99, 6.21, 2146.17}, {98, 0.68, 155.42}, {86, 5.98, 161000.69},
+			{85, 1.30, 6275.96}, {85, 3.67, 71430.70}, {80, 1.81, 17260.15},
+			{79, 3.04, 12036.46}, {75, 1.76, 5088.63}, {74, 3.50, 3154.69},
+			{74, 4.68, 801.82}, {70, 0.83, 9437.76}, {62, 3.98, 8827.39},
+			{61, 1.82, 7084.90}, {57, 2.78, 6286.60}, {56, 4.39, 14143.50},
+			{56, 3.47, 6279.55}, {52, 0.19, 12139.55}, {52, 1.33, 1748.02},
+			{51, 0.28, 5856.48}, {49, 0.49, 1194.45}, {41, 5.37, 8429.24},
+			{41, 2.40, 19651.05}, {39, 6.17, 10447.39}, {37, 6.04, 10213.29},
+			{37, 2.57, 1059.38}, {36, 1.71, 2352.87}, {36, 1.78, 6812.77},
+			{33, 0.59, 17789.85}, {30, 0.44, 83996.85}, {30, 2.74, 1349.87},
+			{25, 3.16, 4690.48},
+		},
+		{
+			{628331966747, 0, 0}, {206059, 2.678235, 6283.07585}, {4303, 2.6351, 12566.1517},
+			{425, 1.590, 3.523}, {119, 5.796, 26.298}, {109, 2.966, 1577.344},
+			{93, 2.59, 18849.23}, {72, 1.14, 529.69}, {68, 1.87, 398.15},
+			{67, 4.41, 5507.55}, {59, 2.89, 5223.69}, {56, 2.17, 155.42},
+			{45, 0.40, 796.30}, {36, 0.47, 775.52}, {29, 2.65, 7.11},
+			{21, 5.34, 0.98}, {19, 1.85, 5486.78}, {19, 4.97, 213.30},
+			{17, 2.99, 6275.96}, {16, 0.03, 2544.31}, {16, 1.43, 2146.17},
+			{15, 1.21, 10977.08}, {12, 2.83, 1748.02}, {12, 3.26, 5088.63},
+			{12, 5.27, 1194.45}, {12, 2.08, 4694.00}, {11, 0.77, 553.57},
+			{10, 1.30, 6286.60}, {10, 4.24, 1349.87}, {9, 2.70, 242.73},
+			{9, 5.64, 951.72}, {8, 5.30, 2352.87}, {6, 2.65, 9437.76},
+			{6, 4.67, 4690.48},
+		},
+		{
+			{52919, 0, 0}, {8720, 1.0721, 6283.0758}, {309, 0.867, 12566.152},
+			{27, 0.05, 3.52}, {16, 5.19, 26.30}, {16, 3.68, 155.42},
+			{10, 0.76, 18849.23}, {9, 2.06, 77713.77}, {7, 0.83, 775.52},
+			{5, 4.66, 1577.34}, {4, 1.03, 7.11}, {4, 3.44, 5573.14},
+			{3, 5.14, 796.30}, {3, 6.05, 5507.55}, {3, 1.19, 242.73},
+			{3, 6.12, 529.69}, {3, 0.31, 398.15}, {3, 2.28, 553.57},
+			{2, 4.38, 5223.69}, {2, 3.75, 0.98},
+		},
+		{
+			{289, 5.844, 6283.076}, {35, 0, 0}, {17, 5.49, 12566.15},
+			{3, 5.20, 155.42}, {1, 4.72, 3.52}, {1, 5.30, 18849.23},
+			{1, 5.97, 242.73},
+		},
+		{
+			{114, 3.142, 0}, {8, 4.13, 6283.08}, {1, 3.84, 12566.15},
+		},
+		{
+			{1, 3.14, 0},
+		},
+	}
+
+	earthR = [...][]vsopTerm{
+		{
+			{100013989, 0, 0}, {1670700, 3.0984635, 6283.0758500}, {13956, 3.05525, 12566.15170},
+			{3084, 5.1985, 77713.7715}, {1628, 1.1739, 5753.3849}, {1576, 2.8469, 7860.4194},
+		},
+		{
+			{103019, 1.107490, 6283.075850}, {1721, 1.0644, 12566.1517},
+		},
+		{
+			{4359, 5.7846, 6283.0758},
+		},
+	}
+)
+
+// vsop sums a VSOP87 series at τ, Julian millennia from J2000.
+func vsop(series [][]vsopTerm, tau float64) float64 {
+	sum, pow := 0.0, 1.0
+	for _, terms := range series {
+		s := 0.0
+		for _, term := range terms {
+			s += term.a * math.Cos(term.b+term.c*tau)
+		}
+		sum += s * pow
+		pow *= tau
+	}
+
+	return sum / 1e8
+}
+
+// sunLongitude computes the apparent longitude of the sun in degrees
+// at a Julian Ephemeris Day, following Meeus, Astronomical Algorithms, ch. 25
+// with truncated VSOP87.
+func sunLongitude(jde float64) float64 {
+	t := (jde - 2451545.0) / 36525
+	tau := t / 10
+
+	// Geometric longitude of the sun is opposite to the earth.
+	lon := vsop(earthL[:], tau)*180/math.Pi + 180
+	r := vsop(earthR[:], tau)
+
+	// Conversion to FK5.
+	lon -= 0.09033 / 3600
+
+	// Nutation in longitude.
+	omega := toRadians(125.04452 - 1934.136261*t)
+	ls := toRadians(280.4665 + 36000.7698*t)
+	lm := toRadians(218.3165 + 481267.8813*t)
+	lon += (-17.20*math.Sin(omega) - 1.32*math.Sin(2*ls) - 0.23*math.Sin(2*lm) + 0.21*math.Sin(2*omega)) / 3600
+
+	// Aberration.
+	lon -= 20.4898 / 3600 / r
+
+	return math.Mod(math.Mod(lon, 360)+360, 360)
+}
+
+// SolarTermTime computes the moment a solar term begins in a Gregorian year.
+// It is accurate to about a minute,
+// so the date could be off by one if the moment is within a minute of midnight.
+func SolarTermTime(year int, s SolarTerm) time.Time {
+	target := s.longitude()
+
+	// Terms are about 15.2 days apart starting from Jan 5.
+	jde := julianDay(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)) + 5 + 15.2*float64(s)
+	for i := 0; i < 20; i++ {
+		diff := math.Mod(target-sunLongitude(jde)+540, 360) - 180
+		if math.Abs(diff) < 1e-7 {
+			break
+		}
+		jde += diff * 365.2422 / 360
+	}
+
+	return timeOfJulianDay(jde - deltaT(float64(year))/86400)
+}
+
+// SolarTermDate returns the date a solar term falls on in China.
+func SolarTermDate(year int, s SolarTerm) Date {
+	return DateIn(SolarTermTime(year, s), TZShanghai)
+}
+
+// SolarTermOf tells the solar term d falls on, if any.
+func SolarTermOf(d Date) (SolarTerm, bool) {
+	y, m, day := d.civil()
+
+	// Each month has two terms, the first one around day 4 to 8
+	// and the second around day 18 to 24.
+	s := SolarTerm(2 * (int(m) - 1))
+	if day > 15 {
+		s++
+	}
+
+	if SolarTermDate(y, s).String() == dateOf(y, m, day).String() {
+		return s, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,145 @@
+package chrono
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMapKey_RoundTrip(t *testing.T) {
+	sales := map[Date]int{
+		mustDate("2024-02-10"): 3,
+		mustDate("2024-02-11"): 5,
+	}
+
+	b, err := json.Marshal(sales)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"2024-02-10":3,"2024-02-11":5}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+
+	var got map[Date]int
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, sales) {
+		t.Errorf("json.Unmarshal() = %v, want %v", got, sales)
+	}
+
+	usage := map[Granularity]Duration{
+		GranularityDay:  DurationOfDays(1),
+		GranularityWeek: DurationOfDays(7),
+	}
+	b, _ = json.Marshal(usage)
+	if string(b) != `{"day":"P1D","week":"P7D"}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+
+	var gotUsage map[Granularity]Duration
+	if err := json.Unmarshal(b, &gotUsage); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotUsage, usage) {
+		t.Errorf("json.Unmarshal() = %v, want %v", gotUsage, usage)
+	}
+}
+
+func TestText_Zero(t *testing.T) {
+	ts := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value encoding.TextMarshaler
+		dest  encoding.TextUnmarshaler
+		want  string
+	}{
+		{"Time", TimeFrom(ts), &Time{}, "2021-03-04T05:06:07Z"},
+		{"Time zero", Time{}, &Time{}, ""},
+		{"Date", DateFrom(ts), &Date{}, "2021-03-04"},
+		{"Date zero", Date{}, &Date{}, ""},
+		{"UnixTime", UnixTimeFrom(ts), &UnixTime{}, "1614834367"},
+		{"UnixMilliTime zero", UnixMilliTime{}, &UnixMilliTime{}, ""},
+		{"TimeMilli", TimeMilliFrom(ts), &TimeMilli{}, "2021-03-04T05:06:07.000Z"},
+		{"Duration", Duration{Months: 1}, &Duration{}, "P1M"},
+		{"Duration zero", Duration{}, &Duration{}, ""},
+		{"Bounds", BoundsClosed, new(Bounds), "[]"},
+		{"Period zero", Period{}, &Period{}, ""},
+		{"Period", mustPeriod("[2024-01-01T00:00:00Z,2024-02-01T00:00:00Z)"), &Period{}, "[2024-01-01T00:00:00Z,2024-02-01T00:00:00Z)"},
+		{"Festival", FestivalMidAutumn, new(Festival), "mid_autumn"},
+		{"SolarTerm", SolarTermStartOfSpring, new(SolarTerm), "start_of_spring"},
+		{"LunarDate", LunarDate{Year: 2024, Month: 4, Day: 15}, &LunarDate{}, "2024-04-15"},
+		{"LunarDate leap", LunarDate{Year: 2023, Month: 2, Day: 10, IsLeap: true}, &LunarDate{}, "2023-L02-10"},
+		{"LunarDate zero", LunarDate{}, &LunarDate{}, ""},
+		{"Locale", LocaleZhHant, new(Locale), "zh-Hant"},
+		{"Precision", PrecisionMilli, new(Precision), "milli"},
+		{"AnchorRule", AnchorClamp, new(AnchorRule), "clamp"},
+		{"ParseMode", ParseLenient, new(ParseMode), "lenient"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := tt.value.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(text) != tt.want {
+				t.Errorf("MarshalText() = %q, want %q", text, tt.want)
+			}
+
+			if err := tt.dest.UnmarshalText(text); err != nil {
+				t.Fatal(err)
+			}
+
+			back, _ := tt.dest.(encoding.TextMarshaler).MarshalText()
+			if string(back) != tt.want {
+				t.Errorf("UnmarshalText() round trip = %q, want %q", back, tt.want)
+			}
+		})
+	}
+}
+
+func TestText_Calendar(t *testing.T) {
+	days := map[Festival]SolarTerm{
+		FestivalQingming:    SolarTermPureBrightness,
+		FestivalDoubleNinth: SolarTermFrostsDescent,
+	}
+
+	b, err := json.Marshal(days)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"double_ninth":"frosts_descent","qingming":"pure_brightness"}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+
+	var got map[Festival]SolarTerm
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, days) {
+		t.Errorf("json.Unmarshal() = %v, want %v", got, days)
+	}
+
+	l := LunarDate{Year: 2023, Month: 2, Day: 10, IsLeap: true}
+	b, _ = json.Marshal(l)
+	if string(b) != `{"year":2023,"month":2,"day":10,"isLeap":true}` {
+		t.Errorf("LunarDate.MarshalJSON() = %s", b)
+	}
+	var back LunarDate
+	if err := json.Unmarshal(b, &back); err != nil || back != l {
+		t.Errorf("LunarDate.UnmarshalJSON() = %v, %v", back, err)
+	}
+
+	for _, s := range []string{"2024-04", "2024-04-15x", "2024-L04-15", "2024-13-01"} {
+		if _, err := ParseLunarDate(s); err == nil {
+			t.Errorf("ParseLunarDate(%q) should fail", s)
+		}
+	}
+	if _, err := ParseFestival(""); err == nil {
+		t.Error("ParseFestival() of empty should fail")
+	}
+}
@@ -2,8 +2,6 @@ package chrono
 
 import (
 	"database/sql/driver"
-	"errors"
-	"fmt"
 	"time"
 )
 
@@ -14,89 +12,74 @@ type Time struct {
 
 // StringEN produces the string representation in English with locale set to UTC.
 func (t Time) StringEN() string {
-	return t.In(time.UTC).Format(time.RFC1123Z)
+	return t.StringENIn(time.UTC)
 }
 
 // StringCN produces the string representation in Chinese format with locale set to Asia/Shanghai.
 func (t Time) StringCN() string {
-	return t.In(TZShanghai).Format(CST)
+	return t.StringCNIn(TZShanghai)
 }
 
-// MarshalJSON converts a Time struct to ISO8601 string.
-func (t Time) MarshalJSON() ([]byte, error) {
-	if y := t.Year(); y < 0 || y >= 10000 {
-		return nil, errors.New("Time.MarshalJSON: year outside of range [0,9999]")
-	}
-	if t.IsZero() {
-		return []byte("null"), nil
-	}
+// StringENIn produces the string representation in English in the specified location.
+func (t Time) StringENIn(loc *time.Location) string {
+	return t.FormatIn(time.RFC1123Z, loc)
+}
+
+// StringCNIn produces the string representation in Chinese format in the specified location.
+func (t Time) StringCNIn(loc *time.Location) string {
+	return t.FormatIn(CST, loc)
+}
 
-	b := make([]byte, 0, len(time.RFC3339)+2)
-	b = append(b, '"')
-	b = t.In(time.UTC).AppendFormat(b, time.RFC3339)
-	b = append(b, '"')
-	return b, nil
+// FormatIn formats the time with layout in the specified location.
+func (t Time) FormatIn(layout string, loc *time.Location) string {
+	return t.In(loc).Format(layout)
+}
+
+// MarshalJSON converts a Time struct to ISO8601 string
+// with fractional seconds of DefaultPrecision.
+func (t Time) MarshalJSON() ([]byte, error) {
+	return marshalTime(t.Time, DefaultPrecision)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// The time is expected to be a quoted string in RFC 3339 format.
+// The time could be a quoted string in any of LenientLayouts,
+// or a number of Unix epoch seconds or milliseconds.
 // Empty string and null will be turned into time.Time zero value.
 func (t *Time) UnmarshalJSON(data []byte) (err error) {
-
-	if string(data) == "null" {
-		t.Time = time.Time{}
-		return
-	}
-
-	t.Time, err = time.Parse(`"`+time.RFC3339+`"`, string(data))
+	t.Time, err = parseJSON(data)
 
 	return
 }
 
 // Scan implements the Scanner interface.
 // SQL NULL will be turned into time zero value.
+// Strings are parsed with ParseLenient in UTC.
 func (t *Time) Scan(value interface{}) (err error) {
-	if value == nil {
-		t.Time = time.Time{}
-		return nil
-	}
-
-	switch v := value.(type) {
-	case time.Time:
-		t.Time = v
-		return
-	case []byte:
-		t.Time, err = ParseDateTime(string(v), time.UTC)
-		return
-	case string:
-		t.Time, err = ParseDateTime(v, time.UTC)
-		return
-	}
+	t.Time, err = scanTime(value)
 
-	return fmt.Errorf("can't convert %T to time.Time", value)
+	return
 }
 
 // Value implements the driver Valuer interface.
 // Zero value is turned into SQL NULL.
+// Fractional seconds are kept according to DefaultPrecision.
 func (t Time) Value() (driver.Value, error) {
-	if t.IsZero() {
-		return nil, nil
-	}
-
-	return t.In(time.UTC).Format(SQLDateTime), nil
+	return timeValue(t.Time, DefaultPrecision)
 }
 
 // TimeNow creates current time.
+// Current time is read from the Clock set by SetClock.
 func TimeNow() Time {
 	return Time{
-		time.Now(),
+		now(),
 	}
 }
 
 // TimeUTCNow creates a Time instance with timezone set to UTC and truncated to second.
+// Use TimeUTCNowPrecise to keep fractional seconds.
 func TimeUTCNow() Time {
 	return Time{
-		time.Now().Truncate(time.Second).UTC(),
+		now().Truncate(time.Second).UTC(),
 	}
 }
 
@@ -115,3 +98,20 @@ func TimeUTCFrom(t time.Time) Time {
 		t.Truncate(time.Second).UTC(),
 	}
 }
+
+// MarshalText implements the encoding.TextMarshaler interface
+// with the same output as MarshalJSON, except that null is empty text.
+func (t Time) MarshalText() ([]byte, error) {
+	return jsonToText(t.MarshalJSON())
+}
+
+// AppendText implements the encoding.TextAppender interface. See appendText.
+func (t Time) AppendText(b []byte) ([]byte, error) {
+	return appendText(b, t)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (t *Time) UnmarshalText(text []byte) error {
+	return t.UnmarshalJSON(textToJSON(text))
+}
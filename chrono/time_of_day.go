@@ -0,0 +1,246 @@
+package chrono
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const secondsOfDay = 24 * secondsOfHour
+
+// TimeOfDay is a wall clock time like 21:30 without date or zone,
+// used by scheduled push and quiet hours.
+// The zero value is unset and turned into JSON null and SQL NULL;
+// use NewTimeOfDay(0, 0, 0) for midnight.
+type TimeOfDay struct {
+	secs  int // Seconds since midnight.
+	valid bool
+}
+
+// NewTimeOfDay creates a TimeOfDay, wrapping around midnight
+// if the clock is out of range.
+func NewTimeOfDay(hour, minute, second int) TimeOfDay {
+	return timeOfDaySeconds(hour*secondsOfHour + minute*secondsOfMinute + second)
+}
+
+func timeOfDaySeconds(secs int) TimeOfDay {
+	return TimeOfDay{
+		secs:  ((secs % secondsOfDay) + secondsOfDay) % secondsOfDay,
+		valid: true,
+	}
+}
+
+// TimeOfDayIn returns the wall clock of t in loc.
+func TimeOfDayIn(t time.Time, loc *time.Location) TimeOfDay {
+	h, m, s := t.In(loc).Clock()
+	return NewTimeOfDay(h, m, s)
+}
+
+// ParseTimeOfDay parses a string like 21:30 or 21:30:15.
+// Fractional seconds are dropped.
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	invalid := fmt.Errorf("%s is not a valid TimeOfDay", s)
+
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return TimeOfDay{}, invalid
+	}
+
+	if len(parts) == 3 {
+		parts[2], _, _ = strings.Cut(parts[2], ".")
+	}
+
+	limits := [...]int{23, 59, 59}
+	var clock [3]int
+	for i, p := range parts {
+		if len(p) == 0 || len(p) > 2 || (i > 0 && len(p) != 2) {
+			return TimeOfDay{}, invalid
+		}
+
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 || n > limits[i] {
+			return TimeOfDay{}, invalid
+		}
+		clock[i] = n
+	}
+
+	return NewTimeOfDay(clock[0], clock[1], clock[2]), nil
+}
+
+// IsZero tests whether the TimeOfDay is unset.
+func (t TimeOfDay) IsZero() bool {
+	return !t.valid
+}
+
+// Hour returns the hour in 0 to 23.
+func (t TimeOfDay) Hour() int {
+	return t.secs / secondsOfHour
+}
+
+// Minute returns the minute in 0 to 59.
+func (t TimeOfDay) Minute() int {
+	return t.secs % secondsOfHour / secondsOfMinute
+}
+
+// Second returns the second in 0 to 59.
+func (t TimeOfDay) Second() int {
+	return t.secs % secondsOfMinute
+}
+
+// String produces 21:30, or 21:30:15 if seconds are not zero.
+// Zero value produces empty string.
+func (t TimeOfDay) String() string {
+	if t.IsZero() {
+		return ""
+	}
+
+	if t.Second() == 0 {
+		return fmt.Sprintf("%02d:%02d", t.Hour(), t.Minute())
+	}
+
+	return fmt.Sprintf("%02d:%02d:%02d", t.Hour(), t.Minute(), t.Second())
+}
+
+// Add moves the clock by d, wrapping around midnight.
+// Fractions of a second are dropped.
+func (t TimeOfDay) Add(d time.Duration) TimeOfDay {
+	return timeOfDaySeconds(t.secs + int(d/time.Second))
+}
+
+// Until returns the duration going forward from t to other,
+// wrapping around midnight, e.g., 22:00 until 07:00 is 9 hours.
+func (t TimeOfDay) Until(other TimeOfDay) time.Duration {
+	secs := ((other.secs-t.secs)%secondsOfDay + secondsOfDay) % secondsOfDay
+	return time.Duration(secs) * time.Second
+}
+
+// Before tests whether t is earlier than other in the same day.
+func (t TimeOfDay) Before(other TimeOfDay) bool {
+	return t.secs < other.secs
+}
+
+// After tests whether t is later than other in the same day.
+func (t TimeOfDay) After(other TimeOfDay) bool {
+	return t.secs > other.secs
+}
+
+// Within tests whether t falls into [start, end).
+// The range wraps around midnight if end is before start,
+// so that quiet hours from 22:00 to 07:00 contain 23:30 and 06:00.
+// The range is empty if start equals end.
+func (t TimeOfDay) Within(start, end TimeOfDay) bool {
+	if start.secs <= end.secs {
+		return t.secs >= start.secs && t.secs < end.secs
+	}
+
+	return t.secs >= start.secs || t.secs < end.secs
+}
+
+// On returns the moment of t on the calendar date d in loc.
+func (t TimeOfDay) On(d Date, loc *time.Location) Time {
+	y, m, day := d.civil()
+	return TimeFrom(time.Date(y, m, day, t.Hour(), t.Minute(), t.Second(), 0, loc))
+}
+
+// Next returns the first moment of t at or after from, as seen in loc.
+func (t TimeOfDay) Next(from time.Time, loc *time.Location) time.Time {
+	d := DateIn(from, loc)
+
+	next := t.On(d, loc).Time
+	if next.Before(from) {
+		next = t.On(d.AddDays(1), loc).Time
+	}
+
+	return next
+}
+
+// TimeWindow returns the Period from start on d to end in loc.
+// End falls on the next day if it is not after start,
+// e.g., 22:00 to 07:00 on 2024-05-01 ends on 2024-05-02.
+func TimeWindow(d Date, start, end TimeOfDay, loc *time.Location) Period {
+	endDate := d
+	if !end.After(start) {
+		endDate = d.AddDays(1)
+	}
+
+	return Period{
+		Start: start.On(d, loc),
+		End:   end.On(endDate, loc),
+	}
+}
+
+// MarshalJSON produces the format of String.
+// Zero value is turned into null.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Null and empty string will be turned into zero value.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if s == nil {
+		*t = TimeOfDay{}
+		return nil
+	}
+
+	return t.UnmarshalText([]byte(*s))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Zero value produces empty text.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value.
+func (t *TimeOfDay) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*t = TimeOfDay{}
+		return nil
+	}
+
+	*t, err = ParseTimeOfDay(string(text))
+	return
+}
+
+// Scan implements the Scanner interface for SQL TIME.
+// SQL NULL will be turned into zero value.
+func (t *TimeOfDay) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*t = TimeOfDay{}
+		return nil
+	case time.Time:
+		*t = NewTimeOfDay(v.Clock())
+		return nil
+	case []byte:
+		return t.UnmarshalText(v)
+	case string:
+		return t.UnmarshalText([]byte(v))
+	}
+
+	return fmt.Errorf("can't convert %T to TimeOfDay", value)
+}
+
+// Value produces SQL TIME like 21:30:00.
+// Zero value is turned into SQL NULL.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+
+	return fmt.Sprintf("%02d:%02d:%02d", t.Hour(), t.Minute(), t.Second()), nil
+}
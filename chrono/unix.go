@@ -0,0 +1,205 @@
+package chrono
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UnixTime is marshalled to JSON as seconds since Unix epoch,
+// used by Stripe.
+// It accepts numbers, numeric strings and any of LenientLayouts from JSON,
+// and scans from SQL DATETIME as well as BIGINT.
+type UnixTime struct {
+	time.Time
+}
+
+// UnixMilliTime is marshalled to JSON as milliseconds since Unix epoch,
+// used by Apple receipts and our native apps.
+// It accepts numbers, numeric strings and any of LenientLayouts from JSON,
+// and scans from SQL DATETIME as well as BIGINT.
+type UnixMilliTime struct {
+	time.Time
+}
+
+// UnixTimeFrom creates a new UnixTime wrapping time.Time.
+func UnixTimeFrom(t time.Time) UnixTime {
+	return UnixTime{t}
+}
+
+// UnixMilliTimeFrom creates a new UnixMilliTime wrapping time.Time.
+func UnixMilliTimeFrom(t time.Time) UnixMilliTime {
+	return UnixMilliTime{t}
+}
+
+// ToTime converts to Time without losing precision.
+func (u UnixTime) ToTime() Time {
+	return Time{u.Time}
+}
+
+// ToTime converts to Time without losing precision.
+func (u UnixMilliTime) ToTime() Time {
+	return Time{u.Time}
+}
+
+// fromEpoch creates a time from seconds or milliseconds.
+func fromEpoch(n int64, milli bool) time.Time {
+	if milli {
+		return time.UnixMilli(n).UTC()
+	}
+
+	return time.Unix(n, 0).UTC()
+}
+
+// parseEpoch parses a numeric string as epoch,
+// or other strings with ParseLenient.
+func parseEpoch(s string, milli bool) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return fromEpoch(n, milli), nil
+	}
+
+	t, _, err := Parse(s, ParseLenient, time.UTC)
+
+	return t, err
+}
+
+func unmarshalEpoch(data []byte, milli bool) (time.Time, error) {
+	s := string(data)
+	if s == "null" {
+		return time.Time{}, nil
+	}
+
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	return parseEpoch(s, milli)
+}
+
+func scanEpoch(value interface{}, milli bool) (time.Time, error) {
+	switch v := value.(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return v, nil
+	case int64:
+		return fromEpoch(v, milli), nil
+	case []byte:
+		return parseEpoch(string(v), milli)
+	case string:
+		return parseEpoch(v, milli)
+	}
+
+	return time.Time{}, fmt.Errorf("can't convert %T to time.Time", value)
+}
+
+// MarshalJSON produces seconds since epoch.
+// Zero value is turned into null.
+func (u UnixTime) MarshalJSON() ([]byte, error) {
+	if u.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return strconv.AppendInt(nil, u.Unix(), 10), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Null and empty string will be turned into zero value.
+func (u *UnixTime) UnmarshalJSON(data []byte) (err error) {
+	u.Time, err = unmarshalEpoch(data, false)
+	return
+}
+
+// Scan implements the Scanner interface.
+// SQL NULL will be turned into zero value.
+func (u *UnixTime) Scan(value interface{}) (err error) {
+	u.Time, err = scanEpoch(value, false)
+	return
+}
+
+// Value saves seconds since epoch.
+// Zero value is turned into SQL NULL.
+// Use ToTime to save into DATETIME columns.
+func (u UnixTime) Value() (driver.Value, error) {
+	if u.IsZero() {
+		return nil, nil
+	}
+
+	return u.Unix(), nil
+}
+
+// MarshalJSON produces milliseconds since epoch.
+// Zero value is turned into null.
+func (u UnixMilliTime) MarshalJSON() ([]byte, error) {
+	if u.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return strconv.AppendInt(nil, u.UnixMilli(), 10), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Null and empty string will be turned into zero value.
+func (u *UnixMilliTime) UnmarshalJSON(data []byte) (err error) {
+	u.Time, err = unmarshalEpoch(data, true)
+	return
+}
+
+// Scan implements the Scanner interface.
+// SQL NULL will be turned into zero value.
+func (u *UnixMilliTime) Scan(value interface{}) (err error) {
+	u.Time, err = scanEpoch(value, true)
+	return
+}
+
+// Value saves milliseconds since epoch.
+// Zero value is turned into SQL NULL.
+// Use ToTime to save into DATETIME columns.
+func (u UnixMilliTime) Value() (driver.Value, error) {
+	if u.IsZero() {
+		return nil, nil
+	}
+
+	return u.UnixMilli(), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+// with the same output as MarshalJSON, except that null is empty text.
+func (u UnixTime) MarshalText() ([]byte, error) {
+	return jsonToText(u.MarshalJSON())
+}
+
+// AppendText implements the encoding.TextAppender interface. See appendText.
+func (u UnixTime) AppendText(b []byte) ([]byte, error) {
+	return appendText(b, u)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (u *UnixTime) UnmarshalText(text []byte) error {
+	return u.UnmarshalJSON(textToJSON(text))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+// with the same output as MarshalJSON, except that null is empty text.
+func (u UnixMilliTime) MarshalText() ([]byte, error) {
+	return jsonToText(u.MarshalJSON())
+}
+
+// AppendText implements the encoding.TextAppender interface. See appendText.
+func (u UnixMilliTime) AppendText(b []byte) ([]byte, error) {
+	return appendText(b, u)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (u *UnixMilliTime) UnmarshalText(text []byte) error {
+	return u.UnmarshalJSON(textToJSON(text))
+}
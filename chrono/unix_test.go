@@ -0,0 +1,68 @@
+package chrono
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestUnixMilliTime_JSON(t *testing.T) {
+	type receipt struct {
+		PurchaseDateMs UnixMilliTime `json:"purchase_date_ms"`
+		CreatedAt      UnixTime      `json:"created"`
+	}
+
+	want := time.Date(2021, 3, 4, 5, 6, 7, 123000000, time.UTC)
+
+	var r receipt
+	err := json.Unmarshal([]byte(`{"purchase_date_ms":"1614834367123","created":1614834367}`), &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !r.PurchaseDateMs.Equal(want) {
+		t.Errorf("UnixMilliTime.UnmarshalJSON() = %v", r.PurchaseDateMs)
+	}
+	if !r.CreatedAt.Equal(want.Truncate(time.Second)) {
+		t.Errorf("UnixTime.UnmarshalJSON() = %v", r.CreatedAt)
+	}
+
+	b, _ := json.Marshal(r)
+	if string(b) != `{"purchase_date_ms":1614834367123,"created":1614834367}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+
+	b, _ = json.Marshal(receipt{})
+	if string(b) != `{"purchase_date_ms":null,"created":null}` {
+		t.Errorf("json.Marshal() zero = %s", b)
+	}
+
+	if !r.PurchaseDateMs.ToTime().Equal(want) {
+		t.Errorf("UnixMilliTime.ToTime() = %v", r.PurchaseDateMs.ToTime())
+	}
+}
+
+func TestUnixTime_Scan(t *testing.T) {
+	want := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	for _, src := range []interface{}{
+		int64(1614834367),
+		[]byte("1614834367"),
+		[]byte("2021-03-04 05:06:07"),
+		want,
+	} {
+		var u UnixTime
+		if err := u.Scan(src); err != nil {
+			t.Errorf("UnixTime.Scan(%v) error = %v", src, err)
+			continue
+		}
+		if !u.Equal(want) {
+			t.Errorf("UnixTime.Scan(%v) = %v", src, u)
+		}
+	}
+
+	v, _ := UnixTimeFrom(want).Value()
+	if v != int64(1614834367) {
+		t.Errorf("UnixTime.Value() = %v", v)
+	}
+}
@@ -0,0 +1,204 @@
+package chrono
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// YearMonthLayout is the format of YearMonth, saved in CHAR(7).
+const YearMonthLayout = "2006-01"
+
+// YearMonth is a calendar month like 2024-05,
+// used by monthly statements and reports.
+// The zero value is turned into JSON null and SQL NULL.
+type YearMonth struct {
+	Year  int
+	Month time.Month
+}
+
+// NewYearMonth creates a YearMonth, normalizing month out of 1 to 12.
+func NewYearMonth(y int, m time.Month) YearMonth {
+	t := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+
+	return YearMonth{t.Year(), t.Month()}
+}
+
+// YearMonthOf returns the month of a Date.
+func YearMonthOf(d Date) YearMonth {
+	y, m, _ := d.civil()
+	return YearMonth{y, m}
+}
+
+// YearMonthIn returns the month of t as seen in loc.
+func YearMonthIn(t time.Time, loc *time.Location) YearMonth {
+	y, m, _ := t.In(loc).Date()
+	return YearMonth{y, m}
+}
+
+// ParseYearMonth parses a string like 2024-05.
+func ParseYearMonth(s string) (YearMonth, error) {
+	t, err := time.Parse(YearMonthLayout, s)
+	if err != nil {
+		return YearMonth{}, fmt.Errorf("%s is not a valid YearMonth", s)
+	}
+
+	return YearMonth{t.Year(), t.Month()}, nil
+}
+
+// IsZero tests whether ym is the zero value.
+func (ym YearMonth) IsZero() bool {
+	return ym == YearMonth{}
+}
+
+// String produces the format like 2024-05.
+// Zero value produces empty string.
+func (ym YearMonth) String() string {
+	if ym.IsZero() {
+		return ""
+	}
+
+	return fmt.Sprintf("%04d-%02d", ym.Year, ym.Month)
+}
+
+// AddMonths moves n months.
+func (ym YearMonth) AddMonths(n int) YearMonth {
+	return NewYearMonth(ym.Year, ym.Month+time.Month(n))
+}
+
+// AddYears moves n years.
+func (ym YearMonth) AddYears(n int) YearMonth {
+	return YearMonth{ym.Year + n, ym.Month}
+}
+
+func (ym YearMonth) index() int {
+	return ym.Year*12 + int(ym.Month) - 1
+}
+
+// Before tests whether ym is earlier than other.
+func (ym YearMonth) Before(other YearMonth) bool {
+	return ym.index() < other.index()
+}
+
+// After tests whether ym is later than other.
+func (ym YearMonth) After(other YearMonth) bool {
+	return ym.index() > other.index()
+}
+
+// MonthsBetween counts months from a to b.
+// The result is negative if b is before a.
+func MonthsBetween(a, b YearMonth) int {
+	return b.index() - a.index()
+}
+
+// Days returns the number of days in the month.
+func (ym YearMonth) Days() int {
+	return daysIn(ym.Year, ym.Month)
+}
+
+// FirstDay returns the first Date of the month.
+func (ym YearMonth) FirstDay() Date {
+	return dateOf(ym.Year, ym.Month, 1)
+}
+
+// LastDay returns the last Date of the month.
+func (ym YearMonth) LastDay() Date {
+	return dateOf(ym.Year, ym.Month, ym.Days())
+}
+
+// Contains tests whether d is in the month.
+func (ym YearMonth) Contains(d Date) bool {
+	return YearMonthOf(d) == ym
+}
+
+// Period returns the month from its first midnight
+// to the first midnight of the next month in loc.
+func (ym YearMonth) Period(loc *time.Location) Period {
+	return Period{
+		Start: TimeFrom(time.Date(ym.Year, ym.Month, 1, 0, 0, 0, 0, loc)),
+		End:   TimeFrom(time.Date(ym.Year, ym.Month+1, 1, 0, 0, 0, 0, loc)),
+	}
+}
+
+// MarshalJSON produces the format like "2024-05".
+// Zero value is turned into null.
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	if ym.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(ym.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Null and empty string will be turned into zero value.
+func (ym *YearMonth) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if s == nil {
+		*ym = YearMonth{}
+		return nil
+	}
+
+	return ym.UnmarshalText([]byte(*s))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Zero value produces empty text.
+func (ym YearMonth) MarshalText() ([]byte, error) {
+	return []byte(ym.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value.
+func (ym *YearMonth) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*ym = YearMonth{}
+		return nil
+	}
+
+	*ym, err = ParseYearMonth(string(text))
+	return
+}
+
+// Scan implements the Scanner interface.
+// SQL NULL will be turned into zero value.
+// DATE values like 2024-05-01 are accepted as well.
+func (ym *YearMonth) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*ym = YearMonth{}
+		return nil
+	case time.Time:
+		*ym = YearMonth{v.Year(), v.Month()}
+		return nil
+	case []byte:
+		return ym.scanString(string(v))
+	case string:
+		return ym.scanString(v)
+	}
+
+	return fmt.Errorf("can't convert %T to YearMonth", value)
+}
+
+func (ym *YearMonth) scanString(s string) error {
+	if len(s) > len(YearMonthLayout) && s[len(YearMonthLayout)] == '-' {
+		s = s[:len(YearMonthLayout)]
+	}
+
+	return ym.UnmarshalText([]byte(s))
+}
+
+// Value implements the driver Valuer interface.
+// Zero value is turned into SQL NULL.
+func (ym YearMonth) Value() (driver.Value, error) {
+	if ym.IsZero() {
+		return nil, nil
+	}
+
+	return ym.String(), nil
+}
@@ -0,0 +1,141 @@
+package chrono
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestYearMonth(t *testing.T) {
+	ym, err := ParseYearMonth("2024-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"AddMonths", ym.AddMonths(13).String(), "2025-02"},
+		{"AddMonths backward", ym.AddMonths(-1).String(), "2023-12"},
+		{"LastDay", ym.AddMonths(1).LastDay().String(), "2024-02-29"},
+		{"YearMonthOf", YearMonthOf(mustDate("2024-05-31")).String(), "2024-05"},
+		{"YearMonthIn", YearMonthIn(time.Date(2024, 4, 30, 20, 0, 0, 0, time.UTC), TZShanghai).String(), "2024-05"},
+		{"Period", ym.Period(TZShanghai).String(), "[2023-12-31T16:00:00Z,2024-01-31T16:00:00Z)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("%s = %s, want %s", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+
+	if MonthsBetween(ym, ym.AddMonths(-5)) != -5 {
+		t.Error("MonthsBetween() mismatch")
+	}
+
+	if _, err := ParseYearMonth("2024-13"); err == nil {
+		t.Error("ParseYearMonth() should reject month 13")
+	}
+}
+
+func TestYearMonth_JSONSQL(t *testing.T) {
+	type statement struct {
+		Month YearMonth `json:"month"`
+		Prev  YearMonth `json:"prev"`
+	}
+
+	var s statement
+	if err := json.Unmarshal([]byte(`{"month":"2024-05","prev":null}`), &s); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(s)
+	if string(b) != `{"month":"2024-05","prev":null}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+
+	var scanned YearMonth
+	if err := scanned.Scan([]byte("2024-05-01")); err != nil || scanned != s.Month {
+		t.Errorf("YearMonth.Scan(DATE) = %v, %v", scanned, err)
+	}
+	if v, _ := scanned.Value(); v != "2024-05" {
+		t.Errorf("YearMonth.Value() = %v", v)
+	}
+}
+
+func TestTimeOfDay(t *testing.T) {
+	quietStart, _ := ParseTimeOfDay("22:00")
+	quietEnd, _ := ParseTimeOfDay("07:00")
+
+	tests := []struct {
+		clock string
+		want  bool
+	}{
+		{"23:30", true},
+		{"06:59:59", true},
+		{"07:00", false},
+		{"12:00", false},
+		{"22:00", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.clock, func(t *testing.T) {
+			c, err := ParseTimeOfDay(tt.clock)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := c.Within(quietStart, quietEnd); got != tt.want {
+				t.Errorf("TimeOfDay.Within() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+
+	if got := quietStart.Add(3 * time.Hour).String(); got != "01:00" {
+		t.Errorf("TimeOfDay.Add() = %s", got)
+	}
+	if got := quietStart.Until(quietEnd); got != 9*time.Hour {
+		t.Errorf("TimeOfDay.Until() = %s", got)
+	}
+
+	w := TimeWindow(mustDate("2024-05-01"), quietStart, quietEnd, TZShanghai)
+	if w.String() != "[2024-05-01T14:00:00Z,2024-05-01T23:00:00Z)" {
+		t.Errorf("TimeWindow() = %s", w)
+	}
+
+	from := time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC) // 23:00 in Shanghai.
+	if got := quietEnd.Next(from, TZShanghai); !got.Equal(time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC)) {
+		t.Errorf("TimeOfDay.Next() = %v", got)
+	}
+
+	for _, s := range []string{"24:00", "21:3", "21:30:60", "abc"} {
+		if _, err := ParseTimeOfDay(s); err == nil {
+			t.Errorf("ParseTimeOfDay(%s) should fail", s)
+		}
+	}
+}
+
+func TestTimeOfDay_JSONSQL(t *testing.T) {
+	type push struct {
+		At    TimeOfDay `json:"at"`
+		Quiet TimeOfDay `json:"quiet"`
+	}
+
+	var p push
+	if err := json.Unmarshal([]byte(`{"at":"21:30","quiet":null}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(p)
+	if string(b) != `{"at":"21:30","quiet":null}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+
+	if v, _ := p.At.Value(); v != "21:30:00" {
+		t.Errorf("TimeOfDay.Value() = %v", v)
+	}
+
+	var midnight TimeOfDay
+	if err := midnight.Scan([]byte("00:00:00")); err != nil || midnight.IsZero() {
+		t.Errorf("TimeOfDay.Scan() midnight = %v, %v", midnight, err)
+	}
+}
@@ -1,6 +1,13 @@
 package chrono
 
-import "time"
+import (
+	"sync"
+	"time"
+
+	// Embed the IANA time zone database so that zones could be loaded
+	// on systems without zoneinfo, like minimal docker images.
+	_ "time/tzdata"
+)
 
 const (
 	secondsOfMinute = 60
@@ -8,6 +15,54 @@ const (
 )
 
 // TZShanghai is fixed time zone set to UTC8.
+// Use LoadZone("Asia/Shanghai") if the historical rules matter.
 var (
 	TZShanghai = time.FixedZone("UTC+8", 8*secondsOfHour)
 )
+
+// zones caches loaded locations keyed by IANA name.
+var zones sync.Map
+
+// LoadZone loads a location by IANA name like Europe/London.
+// Loaded locations are cached.
+func LoadZone(name string) (*time.Location, error) {
+	if loc, ok := zones.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	zones.Store(name, loc)
+
+	return loc, nil
+}
+
+// LoadZoneOr loads a location by IANA name,
+// falling back to fallback if the name is empty or unknown.
+// It is useful for zones saved in user preferences.
+func LoadZoneOr(name string, fallback *time.Location) *time.Location {
+	if name == "" {
+		return fallback
+	}
+
+	loc, err := LoadZone(name)
+	if err != nil {
+		return fallback
+	}
+
+	return loc
+}
+
+// MustLoadZone is like LoadZone but panics on error.
+// It is intended for package-level variables.
+func MustLoadZone(name string) *time.Location {
+	loc, err := LoadZone(name)
+	if err != nil {
+		panic(err)
+	}
+
+	return loc
+}
@@ -0,0 +1,52 @@
+package chrono
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoadZone(t *testing.T) {
+	loc, err := LoadZone("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	summer := TimeFrom(time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC))
+	if got := summer.FormatIn("15:04 MST", loc); got != "08:00 EDT" {
+		t.Errorf("Time.FormatIn() = %s, want 08:00 EDT", got)
+	}
+
+	if _, err := LoadZone("Mars/Olympus"); err == nil {
+		t.Error("LoadZone() expected error for unknown zone")
+	}
+
+	if got := LoadZoneOr("", TZShanghai); got != TZShanghai {
+		t.Errorf("LoadZoneOr() = %s", got)
+	}
+}
+
+func TestDateIn(t *testing.T) {
+	instant := time.Date(2021, 1, 1, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		zone string
+		want string
+	}{
+		{"UTC", "2021-01-01"},
+		{"Asia/Shanghai", "2021-01-02"},
+		{"America/Los_Angeles", "2021-01-01"},
+		{"Pacific/Kiritimati", "2021-01-02"},
+	}
+	for _, tt := range tests {
+		loc := MustLoadZone(tt.zone)
+		d := DateIn(instant, loc)
+
+		if got := d.String(); got != tt.want {
+			t.Errorf("DateIn(%s) = %s, want %s", tt.zone, got, tt.want)
+		}
+
+		if got := d.TimeIn(loc).In(loc).Format(SQLDateTime); got != tt.want+" 00:00:00" {
+			t.Errorf("Date.TimeIn(%s) = %s", tt.zone, got)
+		}
+	}
+}
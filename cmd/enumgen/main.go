@@ -0,0 +1,77 @@
+// Command enumgen generates int based enum types from a JSON spec.
+//
+// For every enum in the spec it writes <file>_gen.go with the
+// constants, Parse, String, StringCN/StringEN, JSON, Text and SQL
+// methods, and <file>_gen_test.go exercising them.
+// Hand-written methods live in separate files of the same package.
+//
+// Usage:
+//
+//	//go:generate go run ../cmd/enumgen -spec enums.json
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("enumgen: ")
+
+	spec := flag.String("spec", "", "path to the JSON spec")
+	out := flag.String("out", "", "output directory; defaults to the directory of the spec")
+	flag.Parse()
+
+	if *spec == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *out == "" {
+		*out = filepath.Dir(*spec)
+	}
+
+	if err := run(*spec, *out); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(specPath, outDir string) error {
+	s, err := LoadSpec(specPath)
+	if err != nil {
+		return err
+	}
+
+	files, err := Generate(s, filepath.Base(specPath))
+	if err != nil {
+		return err
+	}
+
+	for name, b := range files {
+		if err := os.WriteFile(filepath.Join(outDir, name), b, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Generate renders every enum in s, keyed by output file name.
+func Generate(s Spec, source string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	for _, e := range s.Enums {
+		src, test, err := Render(s.Package, source, e)
+		if err != nil {
+			return nil, err
+		}
+
+		files[fmt.Sprintf("%s_gen.go", e.File)] = src
+		files[fmt.Sprintf("%s_gen_test.go", e.File)] = test
+	}
+
+	return files, nil
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerate_upToDate fails when enums.json is edited
+// without running go generate.
+func TestGenerate_upToDate(t *testing.T) {
+	const dir = "../../enum"
+
+	s, err := LoadSpec(filepath.Join(dir, "enums.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := Generate(s, "enums.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is stale, run go generate ./enum", name)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	e := Enum{
+		Type:       "Color",
+		File:       "color",
+		Doc:        "Color is a test enum.",
+		Deprecated: "use Colour.",
+		Receiver:   "c",
+		Values: []Value{
+			{Const: "Red", Name: "red", Aliases: []string{"RED"}, EN: "Red"},
+			{Const: "Blue", Name: "blue", EN: "Blue", Deprecated: "nobody likes blue."},
+		},
+	}
+	if err := e.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	src, test, err := Render("paint", "colors.json", e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"// Code generated by enumgen from colors.json. DO NOT EDIT.",
+		"package paint",
+		"//\n// Deprecated: use Colour.\ntype Color int",
+		"\t// Deprecated: nobody likes blue.\n\tColorBlue\n",
+		`"RED":  ColorRed,`,
+		"func (c Color) StringEN() string {",
+		`fmt.Errorf("%s is not a valid Color", name)`,
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("source does not contain %q", want)
+		}
+	}
+	if strings.Contains(string(src), "StringCN") {
+		t.Error("source should not contain StringCN without cn labels")
+	}
+
+	for _, want := range []string{
+		"func TestColor_Generated(t *testing.T) {",
+		"func TestColor_GeneratedAliases(t *testing.T) {",
+		"func TestColor_GeneratedLabels(t *testing.T) {",
+	} {
+		if !strings.Contains(string(test), want) {
+			t.Errorf("test does not contain %q", want)
+		}
+	}
+}
@@ -0,0 +1,411 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+)
+
+// data is passed to templates.
+type data struct {
+	Enum
+	Package string
+	Source  string // Base name of the spec file, recorded in the header.
+}
+
+var funcs = template.FuncMap{
+	"comment": func(s string) string {
+		return "// " + strings.ReplaceAll(strings.TrimSpace(s), "\n", "\n// ")
+	},
+	"quote": func(s string) string {
+		return fmt.Sprintf("%q", s)
+	},
+}
+
+var (
+	sourceTmpl = template.Must(template.New("source").Funcs(funcs).Parse(sourceText))
+	testTmpl   = template.Must(template.New("test").Funcs(funcs).Parse(testText))
+)
+
+// Render produces the gofmt-ed source and test file of an enum.
+func Render(pkg, source string, e Enum) (src, test []byte, err error) {
+	d := data{
+		Enum:    e,
+		Package: pkg,
+		Source:  source,
+	}
+
+	src, err = execute(sourceTmpl, d)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	test, err = execute(testTmpl, d)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return src, test, nil
+}
+
+func execute(t *template.Template, d data) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, d); err != nil {
+		return nil, fmt.Errorf("%s: %w", d.Type, err)
+	}
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s: %w", d.Type, t.Name(), err)
+	}
+
+	return b, nil
+}
+
+const sourceText = `// Code generated by enumgen from {{.Source}}. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+{{comment .Doc}}
+{{- if .Deprecated}}
+//
+// Deprecated: {{.Deprecated}}
+{{- end}}
+type {{.Type}} int
+
+{{if .ConstDoc}}{{comment .ConstDoc}}
+{{end -}}
+const (
+	{{.Null}} {{.Type}} = iota
+{{- range .Values}}
+{{- if .Deprecated}}
+	// Deprecated: {{.Deprecated}}
+{{- end}}
+	{{$.Prefix}}{{.Const}}{{if .Comment}} // {{.Comment}}{{end}}
+{{- end}}
+)
+
+var {{.Var}}Names = [...]string{
+	"",
+{{- range .Values}}
+	{{quote .Name}},
+{{- end}}
+}
+{{if .HasCN}}
+var {{.Var}}CN = [...]string{
+	"",
+{{- range .Values}}
+	{{quote .CN}},
+{{- end}}
+}
+{{end}}
+{{- if .HasEN}}
+var {{.Var}}EN = [...]string{
+	"",
+{{- range .Values}}
+	{{quote .EN}},
+{{- end}}
+}
+{{end}}
+var {{.Var}}Value = map[string]{{.Type}}{
+{{- range .Values}}
+{{- $c := .Const}}
+	{{quote .Name}}: {{$.Prefix}}{{$c}},
+{{- range .Aliases}}
+	{{quote .}}: {{$.Prefix}}{{$c}},
+{{- end}}
+{{- end}}
+}
+
+// Parse{{.Type}} parses a name or alias into {{.Type}}.
+func Parse{{.Type}}(name string) ({{.Type}}, error) {
+	if x, ok := {{.Var}}Value[name]; ok {
+		return x, nil
+	}
+
+	return {{.Null}}, fmt.Errorf("%s is not a valid {{.Type}}", name)
+}
+
+// String returns the canonical name, or empty string for zero value.
+func ({{.Receiver}} {{.Type}}) String() string {
+	if {{.Receiver}} <= {{.Null}} || {{.Receiver}} > {{.Last}} {
+		return ""
+	}
+
+	return {{.Var}}Names[{{.Receiver}}]
+}
+{{if .HasCN}}
+// StringCN outputs {{.Type}} as Chinese text.
+func ({{.Receiver}} {{.Type}}) StringCN() string {
+	if {{.Receiver}} <= {{.Null}} || {{.Receiver}} > {{.Last}} {
+		return ""
+	}
+
+	return {{.Var}}CN[{{.Receiver}}]
+}
+{{end}}
+{{- if .HasEN}}
+// StringEN outputs {{.Type}} as English text.
+func ({{.Receiver}} {{.Type}}) StringEN() string {
+	if {{.Receiver}} <= {{.Null}} || {{.Receiver}} > {{.Last}} {
+		return ""
+	}
+
+	return {{.Var}}EN[{{.Receiver}}]
+}
+{{end}}
+// UnmarshalJSON implements the Unmarshaler interface.
+// Unknown names are turned into zero value.
+func ({{.Receiver}} *{{.Type}}) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	tmp, _ := Parse{{.Type}}(s)
+
+	*{{.Receiver}} = tmp
+
+	return nil
+}
+
+// MarshalJSON implements the Marshaler interface.
+// Zero value produces null.
+func ({{.Receiver}} {{.Type}}) MarshalJSON() ([]byte, error) {
+	s := {{.Receiver}}.String()
+
+	if s == "" {
+		return []byte("null"), nil
+	}
+
+	return []byte(` + "`\"` + s + `\"`" + `), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func ({{.Receiver}} *{{.Type}}) UnmarshalText(text []byte) error {
+	tmp, _ := Parse{{.Type}}(string(text))
+
+	*{{.Receiver}} = tmp
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Zero value produces empty text.
+func ({{.Receiver}} {{.Type}}) MarshalText() ([]byte, error) {
+	return []byte({{.Receiver}}.String()), nil
+}
+
+// Scan implements sql.Scanner interface to retrieve value from SQL.
+// SQL NULL and unknown names are turned into zero value.
+func ({{.Receiver}} *{{.Type}}) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return ErrIncompatible
+	}
+
+	tmp, _ := Parse{{.Type}}(s)
+
+	*{{.Receiver}} = tmp
+
+	return nil
+}
+
+// Value implements driver.Valuer interface to save value into SQL.
+// Zero value is saved as NULL.
+func ({{.Receiver}} {{.Type}}) Value() (driver.Value, error) {
+	s := {{.Receiver}}.String()
+	if s == "" {
+		return nil, nil
+	}
+
+	return s, nil
+}
+`
+
+const testText = `// Code generated by enumgen from {{.Source}}. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func Test{{.Type}}_Generated(t *testing.T) {
+	tests := []struct {
+		name string
+		x    {{.Type}}
+		want string
+	}{
+{{- range .Values}}
+		{
+			name: {{quote .Const}},
+			x:    {{$.Prefix}}{{.Const}},
+			want: {{quote .Name}},
+		},
+{{- end}}
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("{{.Type}}.String() = %v, want %v", got, tt.want)
+			}
+
+			parsed, err := Parse{{.Type}}(tt.want)
+			if err != nil || parsed != tt.x {
+				t.Errorf("Parse{{.Type}}() = %v, %v, want %v", parsed, err, tt.x)
+			}
+
+			b, err := json.Marshal(tt.x)
+			if err != nil || string(b) != ` + "`\"`+tt.want+`\"`" + ` {
+				t.Errorf("{{.Type}}.MarshalJSON() = %s, %v", b, err)
+			}
+
+			var fromJSON {{.Type}}
+			if err := json.Unmarshal(b, &fromJSON); err != nil || fromJSON != tt.x {
+				t.Errorf("{{.Type}}.UnmarshalJSON() = %v, %v, want %v", fromJSON, err, tt.x)
+			}
+
+			text, _ := tt.x.MarshalText()
+			var fromText {{.Type}}
+			if err := fromText.UnmarshalText(text); err != nil || fromText != tt.x {
+				t.Errorf("{{.Type}}.UnmarshalText() = %v, %v, want %v", fromText, err, tt.x)
+			}
+
+			v, err := tt.x.Value()
+			if err != nil || v != tt.want {
+				t.Errorf("{{.Type}}.Value() = %v, %v, want %v", v, err, tt.want)
+			}
+
+			var fromSQL {{.Type}}
+			if err := fromSQL.Scan([]byte(tt.want)); err != nil || fromSQL != tt.x {
+				t.Errorf("{{.Type}}.Scan() = %v, %v, want %v", fromSQL, err, tt.x)
+			}
+		})
+	}
+}
+
+func Test{{.Type}}_GeneratedZero(t *testing.T) {
+	if s := {{.Null}}.String(); s != "" {
+		t.Errorf("{{.Type}}.String() of zero = %q", s)
+	}
+
+	if b, _ := json.Marshal({{.Null}}); string(b) != "null" {
+		t.Errorf("{{.Type}}.MarshalJSON() of zero = %s", b)
+	}
+
+	if v, _ := {{.Null}}.Value(); v != nil {
+		t.Errorf("{{.Type}}.Value() of zero = %v", v)
+	}
+
+	if _, err := Parse{{.Type}}("not-a-{{.Var}}"); err == nil {
+		t.Error("Parse{{.Type}}() of unknown name should fail")
+	}
+
+	x := {{.Prefix}}{{(index .Values 0).Const}}
+	if err := json.Unmarshal([]byte(` + "`\"not-a-{{.Var}}\"`" + `), &x); err != nil || x != {{.Null}} {
+		t.Errorf("{{.Type}}.UnmarshalJSON() of unknown = %v, %v", x, err)
+	}
+
+	for _, src := range []interface{}{nil, []byte{}, ""} {
+		x = {{.Prefix}}{{(index .Values 0).Const}}
+		if err := x.Scan(src); err != nil || x != {{.Null}} {
+			t.Errorf("{{.Type}}.Scan(%#v) = %v, %v", src, x, err)
+		}
+	}
+
+	x = {{.Prefix}}{{(index .Values 0).Const}}
+	if err := x.Scan([]byte("not-a-{{.Var}}")); err != nil || x != {{.Null}} {
+		t.Errorf("{{.Type}}.Scan() of unknown = %v, %v", x, err)
+	}
+
+	if err := x.Scan(1); err != ErrIncompatible {
+		t.Errorf("{{.Type}}.Scan() of int = %v", err)
+	}
+}
+{{if .HasAliases}}
+func Test{{.Type}}_GeneratedAliases(t *testing.T) {
+	tests := []struct {
+		alias string
+		want  {{.Type}}
+	}{
+{{- range .Values}}
+{{- $c := .Const}}
+{{- range .Aliases}}
+		{
+			alias: {{quote .}},
+			want:  {{$.Prefix}}{{$c}},
+		},
+{{- end}}
+{{- end}}
+	}
+	for _, tt := range tests {
+		t.Run(tt.alias, func(t *testing.T) {
+			got, err := Parse{{.Type}}(tt.alias)
+			if err != nil || got != tt.want {
+				t.Errorf("Parse{{.Type}}() = %v, %v, want %v", got, err, tt.want)
+			}
+
+			var x {{.Type}}
+			if err := x.Scan(tt.alias); err != nil || x != tt.want {
+				t.Errorf("{{.Type}}.Scan() = %v, %v, want %v", x, err, tt.want)
+			}
+		})
+	}
+}
+{{end}}
+{{- if or .HasCN .HasEN}}
+func Test{{.Type}}_GeneratedLabels(t *testing.T) {
+	tests := []struct {
+		x  {{.Type}}
+		cn string
+		en string
+	}{
+		{
+			x: {{.Null}},
+		},
+{{- range .Values}}
+		{
+			x:  {{$.Prefix}}{{.Const}},
+{{- if $.HasCN}}
+			cn: {{quote .CN}},
+{{- end}}
+{{- if $.HasEN}}
+			en: {{quote .EN}},
+{{- end}}
+		},
+{{- end}}
+	}
+	for _, tt := range tests {
+		t.Run(tt.x.String(), func(t *testing.T) {
+{{- if .HasCN}}
+			if got := tt.x.StringCN(); got != tt.cn {
+				t.Errorf("{{.Type}}.StringCN() = %v, want %v", got, tt.cn)
+			}
+{{- end}}
+{{- if .HasEN}}
+			if got := tt.x.StringEN(); got != tt.en {
+				t.Errorf("{{.Type}}.StringEN() = %v, want %v", got, tt.en)
+			}
+{{- end}}
+		})
+	}
+}
+{{end -}}
+`
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Spec is the declarative description of all enums in a package.
+type Spec struct {
+	Package string `json:"package"`
+	Enums   []Enum `json:"enums"`
+}
+
+// Enum describes a single int based enum type.
+// Its zero value is always <Prefix>Null and serializes to
+// empty string, JSON null and SQL NULL.
+type Enum struct {
+	Type       string  `json:"type"`       // Name of the Go type, e.g. Tier.
+	File       string  `json:"file"`       // Base name of the generated files, e.g. tier.
+	Doc        string  `json:"doc"`        // Doc comment of the type.
+	Deprecated string  `json:"deprecated"` // Deprecation note of the type, if any.
+	ConstDoc   string  `json:"constDoc"`   // Doc comment of the const block.
+	Prefix     string  `json:"prefix"`     // Prefix of constants. Defaults to Type.
+	Var        string  `json:"var"`        // Prefix of unexported lookup tables. Defaults to Type in lower camel case.
+	Receiver   string  `json:"receiver"`   // Method receiver name. Defaults to x.
+	Values     []Value `json:"values"`
+}
+
+// Value describes a non-zero value of an enum.
+type Value struct {
+	Const      string   `json:"const"`      // Constant name after prefix, e.g. Standard for TierStandard.
+	Name       string   `json:"name"`       // Canonical name used by String, JSON, Text and SQL.
+	Aliases    []string `json:"aliases"`    // Other names accepted by Parse.
+	CN         string   `json:"cn"`         // Chinese label.
+	EN         string   `json:"en"`         // English label.
+	Comment    string   `json:"comment"`    // Trailing comment on the constant.
+	Deprecated string   `json:"deprecated"` // Deprecation note of the constant, if any.
+}
+
+// LoadSpec reads and validates a spec file.
+func LoadSpec(path string) (Spec, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Spec{}, err
+	}
+
+	var s Spec
+	if err := json.Unmarshal(b, &s); err != nil {
+		return Spec{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if err := s.Validate(); err != nil {
+		return Spec{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+// Validate fills defaults and checks that every enum
+// could be rendered into compilable and unambiguous code.
+func (s *Spec) Validate() error {
+	if !token.IsIdentifier(s.Package) {
+		return fmt.Errorf("invalid package name %q", s.Package)
+	}
+
+	types := make(map[string]bool)
+	files := make(map[string]bool)
+	for i := range s.Enums {
+		e := &s.Enums[i]
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		if types[e.Type] {
+			return fmt.Errorf("duplicate enum type %s", e.Type)
+		}
+		if files[e.File] {
+			return fmt.Errorf("duplicate enum file %s", e.File)
+		}
+		types[e.Type] = true
+		files[e.File] = true
+	}
+
+	return nil
+}
+
+// Validate fills defaults and checks the enum.
+func (e *Enum) Validate() error {
+	if !token.IsExported(e.Type) || !token.IsIdentifier(e.Type) {
+		return fmt.Errorf("enum type %q is not an exported identifier", e.Type)
+	}
+	if e.File == "" || strings.ContainsAny(e.File, `/\.`) {
+		return fmt.Errorf("%s: invalid file name %q", e.Type, e.File)
+	}
+	if e.Prefix == "" {
+		e.Prefix = e.Type
+	}
+	if e.Var == "" {
+		e.Var = lowerFirst(e.Type)
+	}
+	if e.Receiver == "" {
+		e.Receiver = "x"
+	}
+	if !token.IsExported(e.Prefix) || !token.IsIdentifier(e.Prefix) {
+		return fmt.Errorf("%s: prefix %q is not an exported identifier", e.Type, e.Prefix)
+	}
+	if token.IsExported(e.Var) || !token.IsIdentifier(e.Var) {
+		return fmt.Errorf("%s: var %q is not an unexported identifier", e.Type, e.Var)
+	}
+	if !token.IsIdentifier(e.Receiver) {
+		return fmt.Errorf("%s: invalid receiver %q", e.Type, e.Receiver)
+	}
+	if len(e.Values) == 0 {
+		return fmt.Errorf("%s: no values", e.Type)
+	}
+
+	consts := map[string]bool{"Null": true}
+	names := make(map[string]string)
+	var withCN, withEN int
+	for _, v := range e.Values {
+		if !token.IsIdentifier(e.Prefix + v.Const) {
+			return fmt.Errorf("%s: invalid constant %q", e.Type, e.Prefix+v.Const)
+		}
+		if consts[v.Const] {
+			return fmt.Errorf("%s: duplicate constant %s%s", e.Type, e.Prefix, v.Const)
+		}
+		consts[v.Const] = true
+
+		for _, name := range append([]string{v.Name}, v.Aliases...) {
+			if name == "" {
+				return fmt.Errorf("%s%s: empty name or alias", e.Prefix, v.Const)
+			}
+			if prev, ok := names[name]; ok {
+				return fmt.Errorf("%s: %q is used by both %s and %s", e.Type, name, prev, e.Prefix+v.Const)
+			}
+			names[name] = e.Prefix + v.Const
+		}
+
+		if v.CN != "" {
+			withCN++
+		}
+		if v.EN != "" {
+			withEN++
+		}
+	}
+
+	if withCN != 0 && withCN != len(e.Values) {
+		return fmt.Errorf("%s: cn labels must be given for all values or none", e.Type)
+	}
+	if withEN != 0 && withEN != len(e.Values) {
+		return fmt.Errorf("%s: en labels must be given for all values or none", e.Type)
+	}
+
+	return nil
+}
+
+// HasCN tells whether StringCN should be generated.
+func (e Enum) HasCN() bool {
+	return e.Values[0].CN != ""
+}
+
+// HasEN tells whether StringEN should be generated.
+func (e Enum) HasEN() bool {
+	return e.Values[0].EN != ""
+}
+
+// HasAliases tells whether any value accepts an alias.
+func (e Enum) HasAliases() bool {
+	for _, v := range e.Values {
+		if len(v.Aliases) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Null is the name of the zero value constant.
+func (e Enum) Null() string {
+	return e.Prefix + "Null"
+}
+
+// Last is the name of the largest constant.
+func (e Enum) Last() string {
+	return e.Prefix + e.Values[len(e.Values)-1].Const
+}
+
+func lowerFirst(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[n:]
+}
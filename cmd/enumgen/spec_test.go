@@ -0,0 +1,105 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEnum_Validate(t *testing.T) {
+	valid := func() Enum {
+		return Enum{
+			Type: "Tier",
+			File: "tier",
+			Values: []Value{
+				{Const: "Standard", Name: "standard", CN: "标准会员"},
+				{Const: "Premium", Name: "premium", CN: "高级会员"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(e *Enum)
+		wantErr string
+	}{
+		{
+			name:   "Valid",
+			modify: func(e *Enum) {},
+		},
+		{
+			name:    "Unexported type",
+			modify:  func(e *Enum) { e.Type = "tier" },
+			wantErr: "not an exported identifier",
+		},
+		{
+			name:    "File with extension",
+			modify:  func(e *Enum) { e.File = "tier.go" },
+			wantErr: "invalid file name",
+		},
+		{
+			name:    "No values",
+			modify:  func(e *Enum) { e.Values = nil },
+			wantErr: "no values",
+		},
+		{
+			name:    "Constant clashes with zero value",
+			modify:  func(e *Enum) { e.Values[0].Const = "Null" },
+			wantErr: "duplicate constant TierNull",
+		},
+		{
+			name:    "Duplicate name",
+			modify:  func(e *Enum) { e.Values[1].Name = "standard" },
+			wantErr: `"standard" is used by both`,
+		},
+		{
+			name:    "Alias clashes with name",
+			modify:  func(e *Enum) { e.Values[1].Aliases = []string{"standard"} },
+			wantErr: `"standard" is used by both`,
+		},
+		{
+			name:    "Empty alias",
+			modify:  func(e *Enum) { e.Values[0].Aliases = []string{""} },
+			wantErr: "empty name or alias",
+		},
+		{
+			name:    "Partial labels",
+			modify:  func(e *Enum) { e.Values[1].CN = "" },
+			wantErr: "cn labels",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := valid()
+			tt.modify(&e)
+
+			err := e.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Enum.Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Enum.Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEnum_Validate_defaults(t *testing.T) {
+	e := Enum{
+		Type:   "PayMethod",
+		File:   "pay_method",
+		Values: []Value{{Const: "Ali", Name: "alipay"}},
+	}
+	if err := e.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if e.Prefix != "PayMethod" || e.Var != "payMethod" || e.Receiver != "x" {
+		t.Errorf("Enum.Validate() defaults = %q %q %q", e.Prefix, e.Var, e.Receiver)
+	}
+	if e.Null() != "PayMethodNull" || e.Last() != "PayMethodAli" {
+		t.Errorf("Null() = %s, Last() = %s", e.Null(), e.Last())
+	}
+}
@@ -0,0 +1,181 @@
+package gorest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrInvalidCursor is returned when a cursor is malformed or its signature does not match.
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// Cursor is the position of a row in a keyset-paginated list.
+// Key is the value of the sort column; ID breaks ties among rows
+// having the same sort key.
+type Cursor struct {
+	Key string `json:"k"`
+	ID  string `json:"id"`
+}
+
+// IsZero tests whether the cursor points to nowhere,
+// which means the beginning of a list.
+func (c Cursor) IsZero() bool {
+	return c.Key == "" && c.ID == ""
+}
+
+// CursorCodec encodes a Cursor into an opaque string signed with HMAC-SHA256,
+// and decodes it back after verifying the signature.
+type CursorCodec struct {
+	key []byte
+}
+
+// NewCursorCodec creates a new CursorCodec with the secret key used to sign cursors.
+func NewCursorCodec(key []byte) CursorCodec {
+	return CursorCodec{
+		key: key,
+	}
+}
+
+func (c CursorCodec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// Encode turns a cursor into an url-safe string.
+// Zero cursor is encoded to an empty string.
+func (c CursorCodec) Encode(cur Cursor) string {
+	if cur.IsZero() {
+		return ""
+	}
+
+	// Marshalling a struct of strings never fails.
+	payload, _ := json.Marshal(cur)
+
+	return base64.RawURLEncoding.EncodeToString(payload) +
+		"." +
+		base64.RawURLEncoding.EncodeToString(c.sign(payload))
+}
+
+// Decode verifies and parses a string produced by Encode.
+// Empty string is decoded to a zero cursor.
+func (c CursorCodec) Decode(s string) (Cursor, error) {
+	if s == "" {
+		return Cursor{}, nil
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) != 2 {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	if !hmac.Equal(sig, c.sign(payload)) {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var cur Cursor
+	if err := json.Unmarshal(payload, &cur); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return cur, nil
+}
+
+// CursorPagination is used to retrieve a page of keyset-paginated list.
+// Set After to move forward from a cursor, or Before to move backward.
+// If both are empty, the first page is requested.
+type CursorPagination struct {
+	After  string `query:"after" schema:"after" json:"after"`
+	Before string `query:"before" schema:"before" json:"before"`
+	Limit  int64  `query:"per_page" schema:"per_page" json:"limit"`
+}
+
+// Normalize corrects invalid values using DefaultPaginationPolicy.
+// Before is ignored if After is present.
+func (p *CursorPagination) Normalize() {
+	*p, _ = DefaultPaginationPolicy.CheckCursor(*p)
+}
+
+// IsBackward tests whether the list should be retrieved in reverse order.
+// When it is true, the SQL should compare and order in the opposite direction,
+// and the retrieved rows should be reversed before sending back.
+func (p CursorPagination) IsBackward() bool {
+	return p.After == "" && p.Before != ""
+}
+
+// FetchLimit is the number of rows to retrieve from SQL.
+// It retrieves one more row than Limit so that we know whether there are more rows.
+func (p CursorPagination) FetchLimit() int64 {
+	return p.Limit + 1
+}
+
+// Cursor verifies and decodes the cursor to start from.
+func (p CursorPagination) Cursor(codec CursorCodec) (Cursor, error) {
+	if p.IsBackward() {
+		return codec.Decode(p.Before)
+	}
+
+	return codec.Decode(p.After)
+}
+
+// CursorPage is the metadata of a keyset-paginated list sent back to client.
+// Pass NextCursor as the `after` parameter to get the next page,
+// and PrevCursor as the `before` parameter to get the previous page.
+type CursorPage struct {
+	Limit      int64  `json:"limit"`
+	NextCursor string `json:"next_cursor"`
+	PrevCursor string `json:"prev_cursor"`
+	HasNext    bool   `json:"has_next"`
+	HasPrev    bool   `json:"has_prev"`
+}
+
+// NewCursorPage builds the metadata of a page.
+// first and last are the cursors of the first and the last row of the page
+// in display order; use zero cursors for an empty page.
+// hasMore indicates whether more rows than the requested limit were retrieved,
+// in the direction of the request.
+func NewCursorPage(p CursorPagination, codec CursorCodec, first, last Cursor, hasMore bool) CursorPage {
+	page := CursorPage{
+		Limit: p.Limit,
+	}
+
+	if p.IsBackward() {
+		page.HasPrev = hasMore
+		// We came from a later page.
+		page.HasNext = true
+	} else {
+		page.HasNext = hasMore
+		page.HasPrev = p.After != ""
+	}
+
+	if page.HasNext {
+		page.NextCursor = codec.Encode(last)
+	}
+
+	if page.HasPrev {
+		page.PrevCursor = codec.Encode(first)
+	}
+
+	return page
+}
+
+// GetCursorPagination extracts keyset pagination information from query parameter
+// using DefaultPaginationPolicy.
+func GetCursorPagination(req *http.Request) CursorPagination {
+	p, _ := DefaultPaginationPolicy.GetCursorPagination(req)
+	return p
+}
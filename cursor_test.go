@@ -0,0 +1,109 @@
+package gorest
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCursorCodec(t *testing.T) {
+	codec := NewCursorCodec([]byte("secret"))
+
+	cur := Cursor{
+		Key: "2021-01-02 15:04:05",
+		ID:  "order-123",
+	}
+
+	s := codec.Encode(cur)
+	t.Logf("Encoded cursor: %s", s)
+
+	got, err := codec.Decode(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != cur {
+		t.Errorf("CursorCodec.Decode() = %v, want %v", got, cur)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "Tampered payload",
+			input: "x" + s,
+		},
+		{
+			name:  "Signed by another key",
+			input: NewCursorCodec([]byte("other")).Encode(cur),
+		},
+		{
+			name:  "Malformed",
+			input: "abc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := codec.Decode(tt.input); err != ErrInvalidCursor {
+				t.Errorf("CursorCodec.Decode() error = %v, want %v", err, ErrInvalidCursor)
+			}
+		})
+	}
+}
+
+func TestNewCursorPage(t *testing.T) {
+	codec := NewCursorCodec([]byte("secret"))
+	first := Cursor{Key: "1", ID: "a"}
+	last := Cursor{Key: "9", ID: "z"}
+
+	tests := []struct {
+		name     string
+		query    string
+		hasMore  bool
+		wantNext bool
+		wantPrev bool
+	}{
+		{
+			name:     "First page",
+			query:    "",
+			hasMore:  true,
+			wantNext: true,
+			wantPrev: false,
+		},
+		{
+			name:     "Last page forward",
+			query:    "after=" + codec.Encode(first),
+			hasMore:  false,
+			wantNext: false,
+			wantPrev: true,
+		},
+		{
+			name:     "Backward",
+			query:    "before=" + codec.Encode(last),
+			hasMore:  false,
+			wantNext: true,
+			wantPrev: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/orders?"+tt.query, nil)
+			_ = req.ParseForm()
+
+			p := GetCursorPagination(req)
+			if _, err := p.Cursor(codec); err != nil {
+				t.Fatal(err)
+			}
+
+			page := NewCursorPage(p, codec, first, last, tt.hasMore)
+			if page.HasNext != tt.wantNext || page.HasPrev != tt.wantPrev {
+				t.Errorf("NewCursorPage() = %+v, want next %t, prev %t", page, tt.wantNext, tt.wantPrev)
+			}
+			if page.HasNext && page.NextCursor != codec.Encode(last) {
+				t.Errorf("NewCursorPage() next cursor = %s", page.NextCursor)
+			}
+			if page.HasPrev && page.PrevCursor != codec.Encode(first) {
+				t.Errorf("NewCursorPage() prev cursor = %s", page.PrevCursor)
+			}
+		})
+	}
+}
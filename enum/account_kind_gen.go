@@ -0,0 +1,126 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// AccountKind tells how a reader's account is linked.
+type AccountKind int
+
+// Allowed values for AccountKind
+const (
+	AccountKindNull AccountKind = iota
+	AccountKindFtc
+	AccountKindWx
+	AccountKindLinked
+)
+
+var accountKindNames = [...]string{
+	"",
+	"ftc",
+	"wechat",
+	"linked",
+}
+
+var accountKindValue = map[string]AccountKind{
+	"ftc":    AccountKindFtc,
+	"wechat": AccountKindWx,
+	"linked": AccountKindLinked,
+}
+
+// ParseAccountKind parses a name or alias into AccountKind.
+func ParseAccountKind(name string) (AccountKind, error) {
+	if x, ok := accountKindValue[name]; ok {
+		return x, nil
+	}
+
+	return AccountKindNull, fmt.Errorf("%s is not a valid AccountKind", name)
+}
+
+// String returns the canonical name, or empty string for zero value.
+func (x AccountKind) String() string {
+	if x <= AccountKindNull || x > AccountKindLinked {
+		return ""
+	}
+
+	return accountKindNames[x]
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+// Unknown names are turned into zero value.
+func (x *AccountKind) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	tmp, _ := ParseAccountKind(s)
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalJSON implements the Marshaler interface.
+// Zero value produces null.
+func (x AccountKind) MarshalJSON() ([]byte, error) {
+	s := x.String()
+
+	if s == "" {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + s + `"`), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (x *AccountKind) UnmarshalText(text []byte) error {
+	tmp, _ := ParseAccountKind(string(text))
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Zero value produces empty text.
+func (x AccountKind) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// Scan implements sql.Scanner interface to retrieve value from SQL.
+// SQL NULL and unknown names are turned into zero value.
+func (x *AccountKind) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return ErrIncompatible
+	}
+
+	tmp, _ := ParseAccountKind(s)
+
+	*x = tmp
+
+	return nil
+}
+
+// Value implements driver.Valuer interface to save value into SQL.
+// Zero value is saved as NULL.
+func (x AccountKind) Value() (driver.Value, error) {
+	s := x.String()
+	if s == "" {
+		return nil, nil
+	}
+
+	return s, nil
+}
@@ -0,0 +1,109 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAccountKind_Generated(t *testing.T) {
+	tests := []struct {
+		name string
+		x    AccountKind
+		want string
+	}{
+		{
+			name: "Ftc",
+			x:    AccountKindFtc,
+			want: "ftc",
+		},
+		{
+			name: "Wx",
+			x:    AccountKindWx,
+			want: "wechat",
+		},
+		{
+			name: "Linked",
+			x:    AccountKindLinked,
+			want: "linked",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("AccountKind.String() = %v, want %v", got, tt.want)
+			}
+
+			parsed, err := ParseAccountKind(tt.want)
+			if err != nil || parsed != tt.x {
+				t.Errorf("ParseAccountKind() = %v, %v, want %v", parsed, err, tt.x)
+			}
+
+			b, err := json.Marshal(tt.x)
+			if err != nil || string(b) != `"`+tt.want+`"` {
+				t.Errorf("AccountKind.MarshalJSON() = %s, %v", b, err)
+			}
+
+			var fromJSON AccountKind
+			if err := json.Unmarshal(b, &fromJSON); err != nil || fromJSON != tt.x {
+				t.Errorf("AccountKind.UnmarshalJSON() = %v, %v, want %v", fromJSON, err, tt.x)
+			}
+
+			text, _ := tt.x.MarshalText()
+			var fromText AccountKind
+			if err := fromText.UnmarshalText(text); err != nil || fromText != tt.x {
+				t.Errorf("AccountKind.UnmarshalText() = %v, %v, want %v", fromText, err, tt.x)
+			}
+
+			v, err := tt.x.Value()
+			if err != nil || v != tt.want {
+				t.Errorf("AccountKind.Value() = %v, %v, want %v", v, err, tt.want)
+			}
+
+			var fromSQL AccountKind
+			if err := fromSQL.Scan([]byte(tt.want)); err != nil || fromSQL != tt.x {
+				t.Errorf("AccountKind.Scan() = %v, %v, want %v", fromSQL, err, tt.x)
+			}
+		})
+	}
+}
+
+func TestAccountKind_GeneratedZero(t *testing.T) {
+	if s := AccountKindNull.String(); s != "" {
+		t.Errorf("AccountKind.String() of zero = %q", s)
+	}
+
+	if b, _ := json.Marshal(AccountKindNull); string(b) != "null" {
+		t.Errorf("AccountKind.MarshalJSON() of zero = %s", b)
+	}
+
+	if v, _ := AccountKindNull.Value(); v != nil {
+		t.Errorf("AccountKind.Value() of zero = %v", v)
+	}
+
+	if _, err := ParseAccountKind("not-a-accountKind"); err == nil {
+		t.Error("ParseAccountKind() of unknown name should fail")
+	}
+
+	x := AccountKindFtc
+	if err := json.Unmarshal([]byte(`"not-a-accountKind"`), &x); err != nil || x != AccountKindNull {
+		t.Errorf("AccountKind.UnmarshalJSON() of unknown = %v, %v", x, err)
+	}
+
+	for _, src := range []interface{}{nil, []byte{}, ""} {
+		x = AccountKindFtc
+		if err := x.Scan(src); err != nil || x != AccountKindNull {
+			t.Errorf("AccountKind.Scan(%#v) = %v, %v", src, x, err)
+		}
+	}
+
+	x = AccountKindFtc
+	if err := x.Scan([]byte("not-a-accountKind")); err != nil || x != AccountKindNull {
+		t.Errorf("AccountKind.Scan() of unknown = %v, %v", x, err)
+	}
+
+	if err := x.Scan(1); err != ErrIncompatible {
+		t.Errorf("AccountKind.Scan() of int = %v", err)
+	}
+}
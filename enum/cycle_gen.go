@@ -0,0 +1,153 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Cycle is an enum for billing cycles.
+type Cycle int
+
+// Supported billing cycles
+const (
+	CycleNull Cycle = iota
+	CycleMonth
+	CycleYear
+)
+
+var cycleNames = [...]string{
+	"",
+	"month",
+	"year",
+}
+
+var cycleCN = [...]string{
+	"",
+	"月",
+	"年",
+}
+
+var cycleEN = [...]string{
+	"",
+	"Month",
+	"Year",
+}
+
+var cycleValue = map[string]Cycle{
+	"month": CycleMonth,
+	"year":  CycleYear,
+}
+
+// ParseCycle parses a name or alias into Cycle.
+func ParseCycle(name string) (Cycle, error) {
+	if x, ok := cycleValue[name]; ok {
+		return x, nil
+	}
+
+	return CycleNull, fmt.Errorf("%s is not a valid Cycle", name)
+}
+
+// String returns the canonical name, or empty string for zero value.
+func (c Cycle) String() string {
+	if c <= CycleNull || c > CycleYear {
+		return ""
+	}
+
+	return cycleNames[c]
+}
+
+// StringCN outputs Cycle as Chinese text.
+func (c Cycle) StringCN() string {
+	if c <= CycleNull || c > CycleYear {
+		return ""
+	}
+
+	return cycleCN[c]
+}
+
+// StringEN outputs Cycle as English text.
+func (c Cycle) StringEN() string {
+	if c <= CycleNull || c > CycleYear {
+		return ""
+	}
+
+	return cycleEN[c]
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+// Unknown names are turned into zero value.
+func (c *Cycle) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	tmp, _ := ParseCycle(s)
+
+	*c = tmp
+
+	return nil
+}
+
+// MarshalJSON implements the Marshaler interface.
+// Zero value produces null.
+func (c Cycle) MarshalJSON() ([]byte, error) {
+	s := c.String()
+
+	if s == "" {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + s + `"`), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (c *Cycle) UnmarshalText(text []byte) error {
+	tmp, _ := ParseCycle(string(text))
+
+	*c = tmp
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Zero value produces empty text.
+func (c Cycle) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// Scan implements sql.Scanner interface to retrieve value from SQL.
+// SQL NULL and unknown names are turned into zero value.
+func (c *Cycle) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return ErrIncompatible
+	}
+
+	tmp, _ := ParseCycle(s)
+
+	*c = tmp
+
+	return nil
+}
+
+// Value implements driver.Valuer interface to save value into SQL.
+// Zero value is saved as NULL.
+func (c Cycle) Value() (driver.Value, error) {
+	s := c.String()
+	if s == "" {
+		return nil, nil
+	}
+
+	return s, nil
+}
@@ -0,0 +1,136 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCycle_Generated(t *testing.T) {
+	tests := []struct {
+		name string
+		x    Cycle
+		want string
+	}{
+		{
+			name: "Month",
+			x:    CycleMonth,
+			want: "month",
+		},
+		{
+			name: "Year",
+			x:    CycleYear,
+			want: "year",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("Cycle.String() = %v, want %v", got, tt.want)
+			}
+
+			parsed, err := ParseCycle(tt.want)
+			if err != nil || parsed != tt.x {
+				t.Errorf("ParseCycle() = %v, %v, want %v", parsed, err, tt.x)
+			}
+
+			b, err := json.Marshal(tt.x)
+			if err != nil || string(b) != `"`+tt.want+`"` {
+				t.Errorf("Cycle.MarshalJSON() = %s, %v", b, err)
+			}
+
+			var fromJSON Cycle
+			if err := json.Unmarshal(b, &fromJSON); err != nil || fromJSON != tt.x {
+				t.Errorf("Cycle.UnmarshalJSON() = %v, %v, want %v", fromJSON, err, tt.x)
+			}
+
+			text, _ := tt.x.MarshalText()
+			var fromText Cycle
+			if err := fromText.UnmarshalText(text); err != nil || fromText != tt.x {
+				t.Errorf("Cycle.UnmarshalText() = %v, %v, want %v", fromText, err, tt.x)
+			}
+
+			v, err := tt.x.Value()
+			if err != nil || v != tt.want {
+				t.Errorf("Cycle.Value() = %v, %v, want %v", v, err, tt.want)
+			}
+
+			var fromSQL Cycle
+			if err := fromSQL.Scan([]byte(tt.want)); err != nil || fromSQL != tt.x {
+				t.Errorf("Cycle.Scan() = %v, %v, want %v", fromSQL, err, tt.x)
+			}
+		})
+	}
+}
+
+func TestCycle_GeneratedZero(t *testing.T) {
+	if s := CycleNull.String(); s != "" {
+		t.Errorf("Cycle.String() of zero = %q", s)
+	}
+
+	if b, _ := json.Marshal(CycleNull); string(b) != "null" {
+		t.Errorf("Cycle.MarshalJSON() of zero = %s", b)
+	}
+
+	if v, _ := CycleNull.Value(); v != nil {
+		t.Errorf("Cycle.Value() of zero = %v", v)
+	}
+
+	if _, err := ParseCycle("not-a-cycle"); err == nil {
+		t.Error("ParseCycle() of unknown name should fail")
+	}
+
+	x := CycleMonth
+	if err := json.Unmarshal([]byte(`"not-a-cycle"`), &x); err != nil || x != CycleNull {
+		t.Errorf("Cycle.UnmarshalJSON() of unknown = %v, %v", x, err)
+	}
+
+	for _, src := range []interface{}{nil, []byte{}, ""} {
+		x = CycleMonth
+		if err := x.Scan(src); err != nil || x != CycleNull {
+			t.Errorf("Cycle.Scan(%#v) = %v, %v", src, x, err)
+		}
+	}
+
+	x = CycleMonth
+	if err := x.Scan([]byte("not-a-cycle")); err != nil || x != CycleNull {
+		t.Errorf("Cycle.Scan() of unknown = %v, %v", x, err)
+	}
+
+	if err := x.Scan(1); err != ErrIncompatible {
+		t.Errorf("Cycle.Scan() of int = %v", err)
+	}
+}
+
+func TestCycle_GeneratedLabels(t *testing.T) {
+	tests := []struct {
+		x  Cycle
+		cn string
+		en string
+	}{
+		{
+			x: CycleNull,
+		},
+		{
+			x:  CycleMonth,
+			cn: "月",
+			en: "Month",
+		},
+		{
+			x:  CycleYear,
+			cn: "年",
+			en: "Year",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.x.String(), func(t *testing.T) {
+			if got := tt.x.StringCN(); got != tt.cn {
+				t.Errorf("Cycle.StringCN() = %v, want %v", got, tt.cn)
+			}
+			if got := tt.x.StringEN(); got != tt.en {
+				t.Errorf("Cycle.StringEN() = %v, want %v", got, tt.en)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Package enum contains the enumerations shared across FTC's API.
+//
+// Types are generated from enums.json by cmd/enumgen into *_gen.go files.
+// Edit the spec and run go generate instead of changing those files;
+// hand-written methods live in the remaining files.
+package enum
+
+//go:generate go run ../cmd/enumgen -spec enums.json
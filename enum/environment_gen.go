@@ -0,0 +1,124 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Environment is the server environment Apple sends a receipt or notification from.
+type Environment int
+
+// Allowed values for Environment
+const (
+	EnvNull       Environment = iota
+	EnvProduction             // Apple also sends PROD in server-to-server notifications.
+	EnvSandbox
+)
+
+var envNames = [...]string{
+	"",
+	"Production",
+	"Sandbox",
+}
+
+var envValue = map[string]Environment{
+	"Production": EnvProduction,
+	"PROD":       EnvProduction,
+	"Sandbox":    EnvSandbox,
+}
+
+// ParseEnvironment parses a name or alias into Environment.
+func ParseEnvironment(name string) (Environment, error) {
+	if x, ok := envValue[name]; ok {
+		return x, nil
+	}
+
+	return EnvNull, fmt.Errorf("%s is not a valid Environment", name)
+}
+
+// String returns the canonical name, or empty string for zero value.
+func (x Environment) String() string {
+	if x <= EnvNull || x > EnvSandbox {
+		return ""
+	}
+
+	return envNames[x]
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+// Unknown names are turned into zero value.
+func (x *Environment) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	tmp, _ := ParseEnvironment(s)
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalJSON implements the Marshaler interface.
+// Zero value produces null.
+func (x Environment) MarshalJSON() ([]byte, error) {
+	s := x.String()
+
+	if s == "" {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + s + `"`), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (x *Environment) UnmarshalText(text []byte) error {
+	tmp, _ := ParseEnvironment(string(text))
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Zero value produces empty text.
+func (x Environment) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// Scan implements sql.Scanner interface to retrieve value from SQL.
+// SQL NULL and unknown names are turned into zero value.
+func (x *Environment) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return ErrIncompatible
+	}
+
+	tmp, _ := ParseEnvironment(s)
+
+	*x = tmp
+
+	return nil
+}
+
+// Value implements driver.Valuer interface to save value into SQL.
+// Zero value is saved as NULL.
+func (x Environment) Value() (driver.Value, error) {
+	s := x.String()
+	if s == "" {
+		return nil, nil
+	}
+
+	return s, nil
+}
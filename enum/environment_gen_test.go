@@ -0,0 +1,129 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnvironment_Generated(t *testing.T) {
+	tests := []struct {
+		name string
+		x    Environment
+		want string
+	}{
+		{
+			name: "Production",
+			x:    EnvProduction,
+			want: "Production",
+		},
+		{
+			name: "Sandbox",
+			x:    EnvSandbox,
+			want: "Sandbox",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("Environment.String() = %v, want %v", got, tt.want)
+			}
+
+			parsed, err := ParseEnvironment(tt.want)
+			if err != nil || parsed != tt.x {
+				t.Errorf("ParseEnvironment() = %v, %v, want %v", parsed, err, tt.x)
+			}
+
+			b, err := json.Marshal(tt.x)
+			if err != nil || string(b) != `"`+tt.want+`"` {
+				t.Errorf("Environment.MarshalJSON() = %s, %v", b, err)
+			}
+
+			var fromJSON Environment
+			if err := json.Unmarshal(b, &fromJSON); err != nil || fromJSON != tt.x {
+				t.Errorf("Environment.UnmarshalJSON() = %v, %v, want %v", fromJSON, err, tt.x)
+			}
+
+			text, _ := tt.x.MarshalText()
+			var fromText Environment
+			if err := fromText.UnmarshalText(text); err != nil || fromText != tt.x {
+				t.Errorf("Environment.UnmarshalText() = %v, %v, want %v", fromText, err, tt.x)
+			}
+
+			v, err := tt.x.Value()
+			if err != nil || v != tt.want {
+				t.Errorf("Environment.Value() = %v, %v, want %v", v, err, tt.want)
+			}
+
+			var fromSQL Environment
+			if err := fromSQL.Scan([]byte(tt.want)); err != nil || fromSQL != tt.x {
+				t.Errorf("Environment.Scan() = %v, %v, want %v", fromSQL, err, tt.x)
+			}
+		})
+	}
+}
+
+func TestEnvironment_GeneratedZero(t *testing.T) {
+	if s := EnvNull.String(); s != "" {
+		t.Errorf("Environment.String() of zero = %q", s)
+	}
+
+	if b, _ := json.Marshal(EnvNull); string(b) != "null" {
+		t.Errorf("Environment.MarshalJSON() of zero = %s", b)
+	}
+
+	if v, _ := EnvNull.Value(); v != nil {
+		t.Errorf("Environment.Value() of zero = %v", v)
+	}
+
+	if _, err := ParseEnvironment("not-a-env"); err == nil {
+		t.Error("ParseEnvironment() of unknown name should fail")
+	}
+
+	x := EnvProduction
+	if err := json.Unmarshal([]byte(`"not-a-env"`), &x); err != nil || x != EnvNull {
+		t.Errorf("Environment.UnmarshalJSON() of unknown = %v, %v", x, err)
+	}
+
+	for _, src := range []interface{}{nil, []byte{}, ""} {
+		x = EnvProduction
+		if err := x.Scan(src); err != nil || x != EnvNull {
+			t.Errorf("Environment.Scan(%#v) = %v, %v", src, x, err)
+		}
+	}
+
+	x = EnvProduction
+	if err := x.Scan([]byte("not-a-env")); err != nil || x != EnvNull {
+		t.Errorf("Environment.Scan() of unknown = %v, %v", x, err)
+	}
+
+	if err := x.Scan(1); err != ErrIncompatible {
+		t.Errorf("Environment.Scan() of int = %v", err)
+	}
+}
+
+func TestEnvironment_GeneratedAliases(t *testing.T) {
+	tests := []struct {
+		alias string
+		want  Environment
+	}{
+		{
+			alias: "PROD",
+			want:  EnvProduction,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.alias, func(t *testing.T) {
+			got, err := ParseEnvironment(tt.alias)
+			if err != nil || got != tt.want {
+				t.Errorf("ParseEnvironment() = %v, %v, want %v", got, err, tt.want)
+			}
+
+			var x Environment
+			if err := x.Scan(tt.alias); err != nil || x != tt.want {
+				t.Errorf("Environment.Scan() = %v, %v, want %v", x, err, tt.want)
+			}
+		})
+	}
+}
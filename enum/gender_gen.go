@@ -1,3 +1,5 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
 package enum
 
 import (
@@ -22,17 +24,12 @@ var genderNames = [...]string{
 	"M",
 }
 
-var genderMap = map[Gender]string{
-	1: genderNames[1],
-	2: genderNames[2],
-}
-
 var genderValue = map[string]Gender{
-	genderNames[1]: 1,
-	genderNames[2]: 2,
+	"F": GenderFemale,
+	"M": GenderMale,
 }
 
-// ParseGender parsed a string into Gender type.
+// ParseGender parses a name or alias into Gender.
 func ParseGender(name string) (Gender, error) {
 	if x, ok := genderValue[name]; ok {
 		return x, nil
@@ -41,15 +38,17 @@ func ParseGender(name string) (Gender, error) {
 	return GenderNull, fmt.Errorf("%s is not a valid Gender", name)
 }
 
+// String returns the canonical name, or empty string for zero value.
 func (g Gender) String() string {
-	if s, ok := genderMap[g]; ok {
-		return s
+	if g <= GenderNull || g > GenderMale {
+		return ""
 	}
 
-	return ""
+	return genderNames[g]
 }
 
 // UnmarshalJSON implements the Unmarshaler interface.
+// Unknown names are turned into zero value.
 func (g *Gender) UnmarshalJSON(b []byte) error {
 	var s string
 	if err := json.Unmarshal(b, &s); err != nil {
@@ -63,9 +62,11 @@ func (g *Gender) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the Marshaler interface
+// MarshalJSON implements the Marshaler interface.
+// Zero value produces null.
 func (g Gender) MarshalJSON() ([]byte, error) {
 	s := g.String()
+
 	if s == "" {
 		return []byte("null"), nil
 	}
@@ -73,24 +74,45 @@ func (g Gender) MarshalJSON() ([]byte, error) {
 	return []byte(`"` + s + `"`), nil
 }
 
-// Scan implements sql.Scanner interface to retrieve enum value from SQL.
-func (g *Gender) Scan(src interface{}) error {
-	if src == nil {
-		*g = GenderNull
-		return nil
-	}
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (g *Gender) UnmarshalText(text []byte) error {
+	tmp, _ := ParseGender(string(text))
+
+	*g = tmp
+
+	return nil
+}
 
-	switch s := src.(type) {
+// MarshalText implements the encoding.TextMarshaler interface.
+// Zero value produces empty text.
+func (g Gender) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+// Scan implements sql.Scanner interface to retrieve value from SQL.
+// SQL NULL and unknown names are turned into zero value.
+func (g *Gender) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
 	case []byte:
-		tmp, _ := ParseGender(string(s))
-		*g = tmp
-		return nil
+		s = string(v)
+	case string:
+		s = v
 	default:
 		return ErrIncompatible
 	}
+
+	tmp, _ := ParseGender(s)
+
+	*g = tmp
+
+	return nil
 }
 
 // Value implements driver.Valuer interface to save value into SQL.
+// Zero value is saved as NULL.
 func (g Gender) Value() (driver.Value, error) {
 	s := g.String()
 	if s == "" {
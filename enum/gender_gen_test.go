@@ -0,0 +1,104 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGender_Generated(t *testing.T) {
+	tests := []struct {
+		name string
+		x    Gender
+		want string
+	}{
+		{
+			name: "Female",
+			x:    GenderFemale,
+			want: "F",
+		},
+		{
+			name: "Male",
+			x:    GenderMale,
+			want: "M",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("Gender.String() = %v, want %v", got, tt.want)
+			}
+
+			parsed, err := ParseGender(tt.want)
+			if err != nil || parsed != tt.x {
+				t.Errorf("ParseGender() = %v, %v, want %v", parsed, err, tt.x)
+			}
+
+			b, err := json.Marshal(tt.x)
+			if err != nil || string(b) != `"`+tt.want+`"` {
+				t.Errorf("Gender.MarshalJSON() = %s, %v", b, err)
+			}
+
+			var fromJSON Gender
+			if err := json.Unmarshal(b, &fromJSON); err != nil || fromJSON != tt.x {
+				t.Errorf("Gender.UnmarshalJSON() = %v, %v, want %v", fromJSON, err, tt.x)
+			}
+
+			text, _ := tt.x.MarshalText()
+			var fromText Gender
+			if err := fromText.UnmarshalText(text); err != nil || fromText != tt.x {
+				t.Errorf("Gender.UnmarshalText() = %v, %v, want %v", fromText, err, tt.x)
+			}
+
+			v, err := tt.x.Value()
+			if err != nil || v != tt.want {
+				t.Errorf("Gender.Value() = %v, %v, want %v", v, err, tt.want)
+			}
+
+			var fromSQL Gender
+			if err := fromSQL.Scan([]byte(tt.want)); err != nil || fromSQL != tt.x {
+				t.Errorf("Gender.Scan() = %v, %v, want %v", fromSQL, err, tt.x)
+			}
+		})
+	}
+}
+
+func TestGender_GeneratedZero(t *testing.T) {
+	if s := GenderNull.String(); s != "" {
+		t.Errorf("Gender.String() of zero = %q", s)
+	}
+
+	if b, _ := json.Marshal(GenderNull); string(b) != "null" {
+		t.Errorf("Gender.MarshalJSON() of zero = %s", b)
+	}
+
+	if v, _ := GenderNull.Value(); v != nil {
+		t.Errorf("Gender.Value() of zero = %v", v)
+	}
+
+	if _, err := ParseGender("not-a-gender"); err == nil {
+		t.Error("ParseGender() of unknown name should fail")
+	}
+
+	x := GenderFemale
+	if err := json.Unmarshal([]byte(`"not-a-gender"`), &x); err != nil || x != GenderNull {
+		t.Errorf("Gender.UnmarshalJSON() of unknown = %v, %v", x, err)
+	}
+
+	for _, src := range []interface{}{nil, []byte{}, ""} {
+		x = GenderFemale
+		if err := x.Scan(src); err != nil || x != GenderNull {
+			t.Errorf("Gender.Scan(%#v) = %v, %v", src, x, err)
+		}
+	}
+
+	x = GenderFemale
+	if err := x.Scan([]byte("not-a-gender")); err != nil || x != GenderNull {
+		t.Errorf("Gender.Scan() of unknown = %v, %v", x, err)
+	}
+
+	if err := x.Scan(1); err != ErrIncompatible {
+		t.Errorf("Gender.Scan() of int = %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// LoginMethod is an enumeration of login method.
+type LoginMethod int
+
+// Allowed values for LoginMethod
+const (
+	LoginMethodNull LoginMethod = iota
+	LoginMethodEmail
+	LoginMethodWx
+	LoginMethodMobile
+)
+
+var loginMethodNames = [...]string{
+	"",
+	"email",
+	"wechat",
+	"mobile",
+}
+
+var loginMethodValue = map[string]LoginMethod{
+	"email":  LoginMethodEmail,
+	"wechat": LoginMethodWx,
+	"mobile": LoginMethodMobile,
+}
+
+// ParseLoginMethod parses a name or alias into LoginMethod.
+func ParseLoginMethod(name string) (LoginMethod, error) {
+	if x, ok := loginMethodValue[name]; ok {
+		return x, nil
+	}
+
+	return LoginMethodNull, fmt.Errorf("%s is not a valid LoginMethod", name)
+}
+
+// String returns the canonical name, or empty string for zero value.
+func (x LoginMethod) String() string {
+	if x <= LoginMethodNull || x > LoginMethodMobile {
+		return ""
+	}
+
+	return loginMethodNames[x]
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+// Unknown names are turned into zero value.
+func (x *LoginMethod) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	tmp, _ := ParseLoginMethod(s)
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalJSON implements the Marshaler interface.
+// Zero value produces null.
+func (x LoginMethod) MarshalJSON() ([]byte, error) {
+	s := x.String()
+
+	if s == "" {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + s + `"`), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (x *LoginMethod) UnmarshalText(text []byte) error {
+	tmp, _ := ParseLoginMethod(string(text))
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Zero value produces empty text.
+func (x LoginMethod) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// Scan implements sql.Scanner interface to retrieve value from SQL.
+// SQL NULL and unknown names are turned into zero value.
+func (x *LoginMethod) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return ErrIncompatible
+	}
+
+	tmp, _ := ParseLoginMethod(s)
+
+	*x = tmp
+
+	return nil
+}
+
+// Value implements driver.Valuer interface to save value into SQL.
+// Zero value is saved as NULL.
+func (x LoginMethod) Value() (driver.Value, error) {
+	s := x.String()
+	if s == "" {
+		return nil, nil
+	}
+
+	return s, nil
+}
@@ -0,0 +1,109 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLoginMethod_Generated(t *testing.T) {
+	tests := []struct {
+		name string
+		x    LoginMethod
+		want string
+	}{
+		{
+			name: "Email",
+			x:    LoginMethodEmail,
+			want: "email",
+		},
+		{
+			name: "Wx",
+			x:    LoginMethodWx,
+			want: "wechat",
+		},
+		{
+			name: "Mobile",
+			x:    LoginMethodMobile,
+			want: "mobile",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("LoginMethod.String() = %v, want %v", got, tt.want)
+			}
+
+			parsed, err := ParseLoginMethod(tt.want)
+			if err != nil || parsed != tt.x {
+				t.Errorf("ParseLoginMethod() = %v, %v, want %v", parsed, err, tt.x)
+			}
+
+			b, err := json.Marshal(tt.x)
+			if err != nil || string(b) != `"`+tt.want+`"` {
+				t.Errorf("LoginMethod.MarshalJSON() = %s, %v", b, err)
+			}
+
+			var fromJSON LoginMethod
+			if err := json.Unmarshal(b, &fromJSON); err != nil || fromJSON != tt.x {
+				t.Errorf("LoginMethod.UnmarshalJSON() = %v, %v, want %v", fromJSON, err, tt.x)
+			}
+
+			text, _ := tt.x.MarshalText()
+			var fromText LoginMethod
+			if err := fromText.UnmarshalText(text); err != nil || fromText != tt.x {
+				t.Errorf("LoginMethod.UnmarshalText() = %v, %v, want %v", fromText, err, tt.x)
+			}
+
+			v, err := tt.x.Value()
+			if err != nil || v != tt.want {
+				t.Errorf("LoginMethod.Value() = %v, %v, want %v", v, err, tt.want)
+			}
+
+			var fromSQL LoginMethod
+			if err := fromSQL.Scan([]byte(tt.want)); err != nil || fromSQL != tt.x {
+				t.Errorf("LoginMethod.Scan() = %v, %v, want %v", fromSQL, err, tt.x)
+			}
+		})
+	}
+}
+
+func TestLoginMethod_GeneratedZero(t *testing.T) {
+	if s := LoginMethodNull.String(); s != "" {
+		t.Errorf("LoginMethod.String() of zero = %q", s)
+	}
+
+	if b, _ := json.Marshal(LoginMethodNull); string(b) != "null" {
+		t.Errorf("LoginMethod.MarshalJSON() of zero = %s", b)
+	}
+
+	if v, _ := LoginMethodNull.Value(); v != nil {
+		t.Errorf("LoginMethod.Value() of zero = %v", v)
+	}
+
+	if _, err := ParseLoginMethod("not-a-loginMethod"); err == nil {
+		t.Error("ParseLoginMethod() of unknown name should fail")
+	}
+
+	x := LoginMethodEmail
+	if err := json.Unmarshal([]byte(`"not-a-loginMethod"`), &x); err != nil || x != LoginMethodNull {
+		t.Errorf("LoginMethod.UnmarshalJSON() of unknown = %v, %v", x, err)
+	}
+
+	for _, src := range []interface{}{nil, []byte{}, ""} {
+		x = LoginMethodEmail
+		if err := x.Scan(src); err != nil || x != LoginMethodNull {
+			t.Errorf("LoginMethod.Scan(%#v) = %v, %v", src, x, err)
+		}
+	}
+
+	x = LoginMethodEmail
+	if err := x.Scan([]byte("not-a-loginMethod")); err != nil || x != LoginMethodNull {
+		t.Errorf("LoginMethod.Scan() of unknown = %v, %v", x, err)
+	}
+
+	if err := x.Scan(1); err != ErrIncompatible {
+		t.Errorf("LoginMethod.Scan() of int = %v", err)
+	}
+}
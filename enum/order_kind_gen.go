@@ -0,0 +1,151 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// OrderKind describes what kind of subscription order
+// a user is purchasing.
+type OrderKind int
+
+// Allowed values for OrderKind
+const (
+	OrderKindNull OrderKind = iota
+	OrderKindCreate
+	OrderKindRenew
+	OrderKindUpgrade
+	OrderKindDowngrade
+	OrderKindAddOn
+)
+
+var orderKindNames = [...]string{
+	"",
+	"create",
+	"renew",
+	"upgrade",
+	"downgrade",
+	"add_on",
+}
+
+var orderKindCN = [...]string{
+	"",
+	"订阅",
+	"续订",
+	"升级订阅",
+	"购买标准版",
+	"补充包",
+}
+
+var orderKindValue = map[string]OrderKind{
+	"create":    OrderKindCreate,
+	"renew":     OrderKindRenew,
+	"upgrade":   OrderKindUpgrade,
+	"downgrade": OrderKindDowngrade,
+	"add_on":    OrderKindAddOn,
+}
+
+// ParseOrderKind parses a name or alias into OrderKind.
+func ParseOrderKind(name string) (OrderKind, error) {
+	if x, ok := orderKindValue[name]; ok {
+		return x, nil
+	}
+
+	return OrderKindNull, fmt.Errorf("%s is not a valid OrderKind", name)
+}
+
+// String returns the canonical name, or empty string for zero value.
+func (x OrderKind) String() string {
+	if x <= OrderKindNull || x > OrderKindAddOn {
+		return ""
+	}
+
+	return orderKindNames[x]
+}
+
+// StringCN outputs OrderKind as Chinese text.
+func (x OrderKind) StringCN() string {
+	if x <= OrderKindNull || x > OrderKindAddOn {
+		return ""
+	}
+
+	return orderKindCN[x]
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+// Unknown names are turned into zero value.
+func (x *OrderKind) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	tmp, _ := ParseOrderKind(s)
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalJSON implements the Marshaler interface.
+// Zero value produces null.
+func (x OrderKind) MarshalJSON() ([]byte, error) {
+	s := x.String()
+
+	if s == "" {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + s + `"`), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (x *OrderKind) UnmarshalText(text []byte) error {
+	tmp, _ := ParseOrderKind(string(text))
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Zero value produces empty text.
+func (x OrderKind) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// Scan implements sql.Scanner interface to retrieve value from SQL.
+// SQL NULL and unknown names are turned into zero value.
+func (x *OrderKind) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return ErrIncompatible
+	}
+
+	tmp, _ := ParseOrderKind(s)
+
+	*x = tmp
+
+	return nil
+}
+
+// Value implements driver.Valuer interface to save value into SQL.
+// Zero value is saved as NULL.
+func (x OrderKind) Value() (driver.Value, error) {
+	s := x.String()
+	if s == "" {
+		return nil, nil
+	}
+
+	return s, nil
+}
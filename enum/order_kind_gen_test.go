@@ -0,0 +1,158 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOrderKind_Generated(t *testing.T) {
+	tests := []struct {
+		name string
+		x    OrderKind
+		want string
+	}{
+		{
+			name: "Create",
+			x:    OrderKindCreate,
+			want: "create",
+		},
+		{
+			name: "Renew",
+			x:    OrderKindRenew,
+			want: "renew",
+		},
+		{
+			name: "Upgrade",
+			x:    OrderKindUpgrade,
+			want: "upgrade",
+		},
+		{
+			name: "Downgrade",
+			x:    OrderKindDowngrade,
+			want: "downgrade",
+		},
+		{
+			name: "AddOn",
+			x:    OrderKindAddOn,
+			want: "add_on",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("OrderKind.String() = %v, want %v", got, tt.want)
+			}
+
+			parsed, err := ParseOrderKind(tt.want)
+			if err != nil || parsed != tt.x {
+				t.Errorf("ParseOrderKind() = %v, %v, want %v", parsed, err, tt.x)
+			}
+
+			b, err := json.Marshal(tt.x)
+			if err != nil || string(b) != `"`+tt.want+`"` {
+				t.Errorf("OrderKind.MarshalJSON() = %s, %v", b, err)
+			}
+
+			var fromJSON OrderKind
+			if err := json.Unmarshal(b, &fromJSON); err != nil || fromJSON != tt.x {
+				t.Errorf("OrderKind.UnmarshalJSON() = %v, %v, want %v", fromJSON, err, tt.x)
+			}
+
+			text, _ := tt.x.MarshalText()
+			var fromText OrderKind
+			if err := fromText.UnmarshalText(text); err != nil || fromText != tt.x {
+				t.Errorf("OrderKind.UnmarshalText() = %v, %v, want %v", fromText, err, tt.x)
+			}
+
+			v, err := tt.x.Value()
+			if err != nil || v != tt.want {
+				t.Errorf("OrderKind.Value() = %v, %v, want %v", v, err, tt.want)
+			}
+
+			var fromSQL OrderKind
+			if err := fromSQL.Scan([]byte(tt.want)); err != nil || fromSQL != tt.x {
+				t.Errorf("OrderKind.Scan() = %v, %v, want %v", fromSQL, err, tt.x)
+			}
+		})
+	}
+}
+
+func TestOrderKind_GeneratedZero(t *testing.T) {
+	if s := OrderKindNull.String(); s != "" {
+		t.Errorf("OrderKind.String() of zero = %q", s)
+	}
+
+	if b, _ := json.Marshal(OrderKindNull); string(b) != "null" {
+		t.Errorf("OrderKind.MarshalJSON() of zero = %s", b)
+	}
+
+	if v, _ := OrderKindNull.Value(); v != nil {
+		t.Errorf("OrderKind.Value() of zero = %v", v)
+	}
+
+	if _, err := ParseOrderKind("not-a-orderKind"); err == nil {
+		t.Error("ParseOrderKind() of unknown name should fail")
+	}
+
+	x := OrderKindCreate
+	if err := json.Unmarshal([]byte(`"not-a-orderKind"`), &x); err != nil || x != OrderKindNull {
+		t.Errorf("OrderKind.UnmarshalJSON() of unknown = %v, %v", x, err)
+	}
+
+	for _, src := range []interface{}{nil, []byte{}, ""} {
+		x = OrderKindCreate
+		if err := x.Scan(src); err != nil || x != OrderKindNull {
+			t.Errorf("OrderKind.Scan(%#v) = %v, %v", src, x, err)
+		}
+	}
+
+	x = OrderKindCreate
+	if err := x.Scan([]byte("not-a-orderKind")); err != nil || x != OrderKindNull {
+		t.Errorf("OrderKind.Scan() of unknown = %v, %v", x, err)
+	}
+
+	if err := x.Scan(1); err != ErrIncompatible {
+		t.Errorf("OrderKind.Scan() of int = %v", err)
+	}
+}
+
+func TestOrderKind_GeneratedLabels(t *testing.T) {
+	tests := []struct {
+		x  OrderKind
+		cn string
+		en string
+	}{
+		{
+			x: OrderKindNull,
+		},
+		{
+			x:  OrderKindCreate,
+			cn: "订阅",
+		},
+		{
+			x:  OrderKindRenew,
+			cn: "续订",
+		},
+		{
+			x:  OrderKindUpgrade,
+			cn: "升级订阅",
+		},
+		{
+			x:  OrderKindDowngrade,
+			cn: "购买标准版",
+		},
+		{
+			x:  OrderKindAddOn,
+			cn: "补充包",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.x.String(), func(t *testing.T) {
+			if got := tt.x.StringCN(); got != tt.cn {
+				t.Errorf("OrderKind.StringCN() = %v, want %v", got, tt.cn)
+			}
+		})
+	}
+}
@@ -1,3 +1,5 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
 package enum
 
 import (
@@ -13,7 +15,7 @@ type PayMethod int
 const (
 	PayMethodNull PayMethod = iota
 	PayMethodAli
-	PayMethodWx
+	PayMethodWx // Legacy rows store it as tenpay.
 	PayMethodStripe
 	PayMethodApple
 	PayMethodB2B
@@ -46,24 +48,16 @@ var payMethodEN = [...]string{
 	"B2B",
 }
 
-var payMethodMap = map[PayMethod]string{
-	PayMethodAli:    payMethodNames[1],
-	PayMethodWx:     payMethodNames[2],
-	PayMethodStripe: payMethodNames[3],
-	PayMethodApple:  payMethodNames[4],
-	PayMethodB2B:    payMethodNames[5],
-}
-
 var payMethodValue = map[string]PayMethod{
-	payMethodNames[1]: PayMethodAli,
-	payMethodNames[2]: PayMethodWx,
-	payMethodNames[3]: PayMethodStripe,
-	payMethodNames[4]: PayMethodApple,
-	payMethodNames[5]: PayMethodB2B,
-	"tenpay":          PayMethodWx,
+	"alipay": PayMethodAli,
+	"wechat": PayMethodWx,
+	"tenpay": PayMethodWx,
+	"stripe": PayMethodStripe,
+	"apple":  PayMethodApple,
+	"b2b":    PayMethodB2B,
 }
 
-// ParsePayMethod parses a string into a PayMethod value.
+// ParsePayMethod parses a name or alias into PayMethod.
 func ParsePayMethod(name string) (PayMethod, error) {
 	if x, ok := payMethodValue[name]; ok {
 		return x, nil
@@ -72,26 +66,27 @@ func ParsePayMethod(name string) (PayMethod, error) {
 	return PayMethodNull, fmt.Errorf("%s is not a valid PayMethod", name)
 }
 
+// String returns the canonical name, or empty string for zero value.
 func (x PayMethod) String() string {
-	if str, ok := payMethodMap[x]; ok {
-		return str
+	if x <= PayMethodNull || x > PayMethodB2B {
+		return ""
 	}
 
-	return ""
+	return payMethodNames[x]
 }
 
-// StringCN output cycle as Chinese text
+// StringCN outputs PayMethod as Chinese text.
 func (x PayMethod) StringCN() string {
-	if x < PayMethodAli || x > PayMethodStripe {
+	if x <= PayMethodNull || x > PayMethodB2B {
 		return ""
 	}
 
 	return payMethodCN[x]
 }
 
-// StringEN output cycle as English text
+// StringEN outputs PayMethod as English text.
 func (x PayMethod) StringEN() string {
-	if x < PayMethodAli || x > PayMethodStripe {
+	if x <= PayMethodNull || x > PayMethodB2B {
 		return ""
 	}
 
@@ -99,6 +94,7 @@ func (x PayMethod) StringEN() string {
 }
 
 // UnmarshalJSON implements the Unmarshaler interface.
+// Unknown names are turned into zero value.
 func (x *PayMethod) UnmarshalJSON(b []byte) error {
 	var s string
 	if err := json.Unmarshal(b, &s); err != nil {
@@ -112,37 +108,57 @@ func (x *PayMethod) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the Marshaler interface
+// MarshalJSON implements the Marshaler interface.
+// Zero value produces null.
 func (x PayMethod) MarshalJSON() ([]byte, error) {
-	str := x.String()
+	s := x.String()
 
-	if str == "" {
+	if s == "" {
 		return []byte("null"), nil
 	}
 
-	return []byte(`"` + str + `"`), nil
+	return []byte(`"` + s + `"`), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (x *PayMethod) UnmarshalText(text []byte) error {
+	tmp, _ := ParsePayMethod(string(text))
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Zero value produces empty text.
+func (x PayMethod) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
 }
 
 // Scan implements sql.Scanner interface to retrieve value from SQL.
-// SQL null will be turned into zero value InvalidPay.
+// SQL NULL and unknown names are turned into zero value.
 func (x *PayMethod) Scan(src interface{}) error {
-	if src == nil {
-		*x = PayMethodNull
-		return nil
-	}
-
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
+	case nil:
 	case []byte:
-		tmp, _ := ParsePayMethod(string(s))
-		*x = tmp
-		return nil
-
+		s = string(v)
+	case string:
+		s = v
 	default:
 		return ErrIncompatible
 	}
+
+	tmp, _ := ParsePayMethod(s)
+
+	*x = tmp
+
+	return nil
 }
 
 // Value implements driver.Valuer interface to save value into SQL.
+// Zero value is saved as NULL.
 func (x PayMethod) Value() (driver.Value, error) {
 	s := x.String()
 	if s == "" {
@@ -128,9 +128,9 @@ func TestPayMethod_MarshalJSON(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name:    "Marshal Tenpay",
+			name:    "Marshal Wechat",
 			x:       PayMethodWx,
-			want:    []byte(`"tenpay"`),
+			want:    []byte(`"wechat"`),
 			wantErr: false,
 		},
 		{
@@ -0,0 +1,126 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Platform is used to record on which platform user is visiting the API.
+type Platform int
+
+// Allowed values for Platform
+const (
+	PlatformNull Platform = iota
+	PlatformWeb
+	PlatformIOS
+	PlatformAndroid
+)
+
+var platformNames = [...]string{
+	"",
+	"web",
+	"ios",
+	"android",
+}
+
+var platformValue = map[string]Platform{
+	"web":     PlatformWeb,
+	"ios":     PlatformIOS,
+	"android": PlatformAndroid,
+}
+
+// ParsePlatform parses a name or alias into Platform.
+func ParsePlatform(name string) (Platform, error) {
+	if x, ok := platformValue[name]; ok {
+		return x, nil
+	}
+
+	return PlatformNull, fmt.Errorf("%s is not a valid Platform", name)
+}
+
+// String returns the canonical name, or empty string for zero value.
+func (x Platform) String() string {
+	if x <= PlatformNull || x > PlatformAndroid {
+		return ""
+	}
+
+	return platformNames[x]
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+// Unknown names are turned into zero value.
+func (x *Platform) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	tmp, _ := ParsePlatform(s)
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalJSON implements the Marshaler interface.
+// Zero value produces null.
+func (x Platform) MarshalJSON() ([]byte, error) {
+	s := x.String()
+
+	if s == "" {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + s + `"`), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (x *Platform) UnmarshalText(text []byte) error {
+	tmp, _ := ParsePlatform(string(text))
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Zero value produces empty text.
+func (x Platform) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// Scan implements sql.Scanner interface to retrieve value from SQL.
+// SQL NULL and unknown names are turned into zero value.
+func (x *Platform) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return ErrIncompatible
+	}
+
+	tmp, _ := ParsePlatform(s)
+
+	*x = tmp
+
+	return nil
+}
+
+// Value implements driver.Valuer interface to save value into SQL.
+// Zero value is saved as NULL.
+func (x Platform) Value() (driver.Value, error) {
+	s := x.String()
+	if s == "" {
+		return nil, nil
+	}
+
+	return s, nil
+}
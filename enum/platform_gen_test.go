@@ -0,0 +1,109 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPlatform_Generated(t *testing.T) {
+	tests := []struct {
+		name string
+		x    Platform
+		want string
+	}{
+		{
+			name: "Web",
+			x:    PlatformWeb,
+			want: "web",
+		},
+		{
+			name: "IOS",
+			x:    PlatformIOS,
+			want: "ios",
+		},
+		{
+			name: "Android",
+			x:    PlatformAndroid,
+			want: "android",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("Platform.String() = %v, want %v", got, tt.want)
+			}
+
+			parsed, err := ParsePlatform(tt.want)
+			if err != nil || parsed != tt.x {
+				t.Errorf("ParsePlatform() = %v, %v, want %v", parsed, err, tt.x)
+			}
+
+			b, err := json.Marshal(tt.x)
+			if err != nil || string(b) != `"`+tt.want+`"` {
+				t.Errorf("Platform.MarshalJSON() = %s, %v", b, err)
+			}
+
+			var fromJSON Platform
+			if err := json.Unmarshal(b, &fromJSON); err != nil || fromJSON != tt.x {
+				t.Errorf("Platform.UnmarshalJSON() = %v, %v, want %v", fromJSON, err, tt.x)
+			}
+
+			text, _ := tt.x.MarshalText()
+			var fromText Platform
+			if err := fromText.UnmarshalText(text); err != nil || fromText != tt.x {
+				t.Errorf("Platform.UnmarshalText() = %v, %v, want %v", fromText, err, tt.x)
+			}
+
+			v, err := tt.x.Value()
+			if err != nil || v != tt.want {
+				t.Errorf("Platform.Value() = %v, %v, want %v", v, err, tt.want)
+			}
+
+			var fromSQL Platform
+			if err := fromSQL.Scan([]byte(tt.want)); err != nil || fromSQL != tt.x {
+				t.Errorf("Platform.Scan() = %v, %v, want %v", fromSQL, err, tt.x)
+			}
+		})
+	}
+}
+
+func TestPlatform_GeneratedZero(t *testing.T) {
+	if s := PlatformNull.String(); s != "" {
+		t.Errorf("Platform.String() of zero = %q", s)
+	}
+
+	if b, _ := json.Marshal(PlatformNull); string(b) != "null" {
+		t.Errorf("Platform.MarshalJSON() of zero = %s", b)
+	}
+
+	if v, _ := PlatformNull.Value(); v != nil {
+		t.Errorf("Platform.Value() of zero = %v", v)
+	}
+
+	if _, err := ParsePlatform("not-a-platform"); err == nil {
+		t.Error("ParsePlatform() of unknown name should fail")
+	}
+
+	x := PlatformWeb
+	if err := json.Unmarshal([]byte(`"not-a-platform"`), &x); err != nil || x != PlatformNull {
+		t.Errorf("Platform.UnmarshalJSON() of unknown = %v, %v", x, err)
+	}
+
+	for _, src := range []interface{}{nil, []byte{}, ""} {
+		x = PlatformWeb
+		if err := x.Scan(src); err != nil || x != PlatformNull {
+			t.Errorf("Platform.Scan(%#v) = %v, %v", src, x, err)
+		}
+	}
+
+	x = PlatformWeb
+	if err := x.Scan([]byte("not-a-platform")); err != nil || x != PlatformNull {
+		t.Errorf("Platform.Scan() of unknown = %v, %v", x, err)
+	}
+
+	if err := x.Scan(1); err != ErrIncompatible {
+		t.Errorf("Platform.Scan() of int = %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// SubsSource tells whether a subscription is bought by a reader or granted by a B2B contract.
+type SubsSource int
+
+// Allowed values for SubsSource
+const (
+	SubsSourceNull SubsSource = iota
+	SubsSourceRetail
+	SubsSourceB2B
+)
+
+var subsSourceNames = [...]string{
+	"",
+	"retail",
+	"b2b",
+}
+
+var subsSourceValue = map[string]SubsSource{
+	"retail": SubsSourceRetail,
+	"b2b":    SubsSourceB2B,
+}
+
+// ParseSubsSource parses a name or alias into SubsSource.
+func ParseSubsSource(name string) (SubsSource, error) {
+	if x, ok := subsSourceValue[name]; ok {
+		return x, nil
+	}
+
+	return SubsSourceNull, fmt.Errorf("%s is not a valid SubsSource", name)
+}
+
+// String returns the canonical name, or empty string for zero value.
+func (x SubsSource) String() string {
+	if x <= SubsSourceNull || x > SubsSourceB2B {
+		return ""
+	}
+
+	return subsSourceNames[x]
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+// Unknown names are turned into zero value.
+func (x *SubsSource) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	tmp, _ := ParseSubsSource(s)
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalJSON implements the Marshaler interface.
+// Zero value produces null.
+func (x SubsSource) MarshalJSON() ([]byte, error) {
+	s := x.String()
+
+	if s == "" {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + s + `"`), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (x *SubsSource) UnmarshalText(text []byte) error {
+	tmp, _ := ParseSubsSource(string(text))
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Zero value produces empty text.
+func (x SubsSource) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// Scan implements sql.Scanner interface to retrieve value from SQL.
+// SQL NULL and unknown names are turned into zero value.
+func (x *SubsSource) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return ErrIncompatible
+	}
+
+	tmp, _ := ParseSubsSource(s)
+
+	*x = tmp
+
+	return nil
+}
+
+// Value implements driver.Valuer interface to save value into SQL.
+// Zero value is saved as NULL.
+func (x SubsSource) Value() (driver.Value, error) {
+	s := x.String()
+	if s == "" {
+		return nil, nil
+	}
+
+	return s, nil
+}
@@ -0,0 +1,104 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSubsSource_Generated(t *testing.T) {
+	tests := []struct {
+		name string
+		x    SubsSource
+		want string
+	}{
+		{
+			name: "Retail",
+			x:    SubsSourceRetail,
+			want: "retail",
+		},
+		{
+			name: "B2B",
+			x:    SubsSourceB2B,
+			want: "b2b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("SubsSource.String() = %v, want %v", got, tt.want)
+			}
+
+			parsed, err := ParseSubsSource(tt.want)
+			if err != nil || parsed != tt.x {
+				t.Errorf("ParseSubsSource() = %v, %v, want %v", parsed, err, tt.x)
+			}
+
+			b, err := json.Marshal(tt.x)
+			if err != nil || string(b) != `"`+tt.want+`"` {
+				t.Errorf("SubsSource.MarshalJSON() = %s, %v", b, err)
+			}
+
+			var fromJSON SubsSource
+			if err := json.Unmarshal(b, &fromJSON); err != nil || fromJSON != tt.x {
+				t.Errorf("SubsSource.UnmarshalJSON() = %v, %v, want %v", fromJSON, err, tt.x)
+			}
+
+			text, _ := tt.x.MarshalText()
+			var fromText SubsSource
+			if err := fromText.UnmarshalText(text); err != nil || fromText != tt.x {
+				t.Errorf("SubsSource.UnmarshalText() = %v, %v, want %v", fromText, err, tt.x)
+			}
+
+			v, err := tt.x.Value()
+			if err != nil || v != tt.want {
+				t.Errorf("SubsSource.Value() = %v, %v, want %v", v, err, tt.want)
+			}
+
+			var fromSQL SubsSource
+			if err := fromSQL.Scan([]byte(tt.want)); err != nil || fromSQL != tt.x {
+				t.Errorf("SubsSource.Scan() = %v, %v, want %v", fromSQL, err, tt.x)
+			}
+		})
+	}
+}
+
+func TestSubsSource_GeneratedZero(t *testing.T) {
+	if s := SubsSourceNull.String(); s != "" {
+		t.Errorf("SubsSource.String() of zero = %q", s)
+	}
+
+	if b, _ := json.Marshal(SubsSourceNull); string(b) != "null" {
+		t.Errorf("SubsSource.MarshalJSON() of zero = %s", b)
+	}
+
+	if v, _ := SubsSourceNull.Value(); v != nil {
+		t.Errorf("SubsSource.Value() of zero = %v", v)
+	}
+
+	if _, err := ParseSubsSource("not-a-subsSource"); err == nil {
+		t.Error("ParseSubsSource() of unknown name should fail")
+	}
+
+	x := SubsSourceRetail
+	if err := json.Unmarshal([]byte(`"not-a-subsSource"`), &x); err != nil || x != SubsSourceNull {
+		t.Errorf("SubsSource.UnmarshalJSON() of unknown = %v, %v", x, err)
+	}
+
+	for _, src := range []interface{}{nil, []byte{}, ""} {
+		x = SubsSourceRetail
+		if err := x.Scan(src); err != nil || x != SubsSourceNull {
+			t.Errorf("SubsSource.Scan(%#v) = %v, %v", src, x, err)
+		}
+	}
+
+	x = SubsSourceRetail
+	if err := x.Scan([]byte("not-a-subsSource")); err != nil || x != SubsSourceNull {
+		t.Errorf("SubsSource.Scan() of unknown = %v, %v", x, err)
+	}
+
+	if err := x.Scan(1); err != ErrIncompatible {
+		t.Errorf("SubsSource.Scan() of int = %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSubsStatus_Generated(t *testing.T) {
+	tests := []struct {
+		name string
+		x    SubsStatus
+		want string
+	}{
+		{
+			name: "Active",
+			x:    SubsStatusActive,
+			want: "active",
+		},
+		{
+			name: "Canceled",
+			x:    SubsStatusCanceled,
+			want: "canceled",
+		},
+		{
+			name: "Incomplete",
+			x:    SubsStatusIncomplete,
+			want: "incomplete",
+		},
+		{
+			name: "IncompleteExpired",
+			x:    SubsStatusIncompleteExpired,
+			want: "incomplete_expired",
+		},
+		{
+			name: "PastDue",
+			x:    SubsStatusPastDue,
+			want: "past_due",
+		},
+		{
+			name: "Trialing",
+			x:    SubsStatusTrialing,
+			want: "trialing",
+		},
+		{
+			name: "Unpaid",
+			x:    SubsStatusUnpaid,
+			want: "unpaid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("SubsStatus.String() = %v, want %v", got, tt.want)
+			}
+
+			parsed, err := ParseSubsStatus(tt.want)
+			if err != nil || parsed != tt.x {
+				t.Errorf("ParseSubsStatus() = %v, %v, want %v", parsed, err, tt.x)
+			}
+
+			b, err := json.Marshal(tt.x)
+			if err != nil || string(b) != `"`+tt.want+`"` {
+				t.Errorf("SubsStatus.MarshalJSON() = %s, %v", b, err)
+			}
+
+			var fromJSON SubsStatus
+			if err := json.Unmarshal(b, &fromJSON); err != nil || fromJSON != tt.x {
+				t.Errorf("SubsStatus.UnmarshalJSON() = %v, %v, want %v", fromJSON, err, tt.x)
+			}
+
+			text, _ := tt.x.MarshalText()
+			var fromText SubsStatus
+			if err := fromText.UnmarshalText(text); err != nil || fromText != tt.x {
+				t.Errorf("SubsStatus.UnmarshalText() = %v, %v, want %v", fromText, err, tt.x)
+			}
+
+			v, err := tt.x.Value()
+			if err != nil || v != tt.want {
+				t.Errorf("SubsStatus.Value() = %v, %v, want %v", v, err, tt.want)
+			}
+
+			var fromSQL SubsStatus
+			if err := fromSQL.Scan([]byte(tt.want)); err != nil || fromSQL != tt.x {
+				t.Errorf("SubsStatus.Scan() = %v, %v, want %v", fromSQL, err, tt.x)
+			}
+		})
+	}
+}
+
+func TestSubsStatus_GeneratedZero(t *testing.T) {
+	if s := SubsStatusNull.String(); s != "" {
+		t.Errorf("SubsStatus.String() of zero = %q", s)
+	}
+
+	if b, _ := json.Marshal(SubsStatusNull); string(b) != "null" {
+		t.Errorf("SubsStatus.MarshalJSON() of zero = %s", b)
+	}
+
+	if v, _ := SubsStatusNull.Value(); v != nil {
+		t.Errorf("SubsStatus.Value() of zero = %v", v)
+	}
+
+	if _, err := ParseSubsStatus("not-a-subsStatus"); err == nil {
+		t.Error("ParseSubsStatus() of unknown name should fail")
+	}
+
+	x := SubsStatusActive
+	if err := json.Unmarshal([]byte(`"not-a-subsStatus"`), &x); err != nil || x != SubsStatusNull {
+		t.Errorf("SubsStatus.UnmarshalJSON() of unknown = %v, %v", x, err)
+	}
+
+	for _, src := range []interface{}{nil, []byte{}, ""} {
+		x = SubsStatusActive
+		if err := x.Scan(src); err != nil || x != SubsStatusNull {
+			t.Errorf("SubsStatus.Scan(%#v) = %v, %v", src, x, err)
+		}
+	}
+
+	x = SubsStatusActive
+	if err := x.Scan([]byte("not-a-subsStatus")); err != nil || x != SubsStatusNull {
+		t.Errorf("SubsStatus.Scan() of unknown = %v, %v", x, err)
+	}
+
+	if err := x.Scan(1); err != ErrIncompatible {
+		t.Errorf("SubsStatus.Scan() of int = %v", err)
+	}
+}
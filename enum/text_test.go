@@ -0,0 +1,52 @@
+package enum
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMapKey_RoundTrip(t *testing.T) {
+	prices := map[Tier]map[Cycle]float64{
+		TierStandard: {
+			CycleMonth: 35,
+			CycleYear:  298,
+		},
+		TierPremium: {
+			CycleYear: 1998,
+		},
+	}
+
+	b, err := json.Marshal(prices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"premium":{"year":1998},"standard":{"month":35,"year":298}}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+
+	var got map[Tier]map[Cycle]float64
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, prices) {
+		t.Errorf("json.Unmarshal() = %v, want %v", got, prices)
+	}
+}
+
+func TestText_Zero(t *testing.T) {
+	text, _ := PayMethodNull.MarshalText()
+	if len(text) != 0 {
+		t.Errorf("MarshalText() of zero = %q", text)
+	}
+
+	x := PayMethodAli
+	if err := x.UnmarshalText(nil); err != nil || x != PayMethodNull {
+		t.Errorf("UnmarshalText() of empty = %v, %v", x, err)
+	}
+
+	var p Platform
+	if err := p.UnmarshalText([]byte("ios")); err != nil || p != PlatformIOS {
+		t.Errorf("UnmarshalText() = %v, %v", p, err)
+	}
+}
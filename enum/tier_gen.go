@@ -1,3 +1,5 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
 package enum
 
 import (
@@ -24,33 +26,27 @@ var tierNames = [...]string{
 	"vip",
 }
 
-var tiersCN = [...]string{
+var tierCN = [...]string{
 	"",
 	"标准会员",
 	"高级会员",
 	"VIP",
 }
 
-var tiersEN = [...]string{
+var tierEN = [...]string{
 	"",
 	"Standard",
 	"Premium",
 	"VIP",
 }
 
-var tierMap = map[Tier]string{
-	1: tierNames[1],
-	2: tierNames[2],
-	3: tierNames[3],
-}
-
 var tierValue = map[string]Tier{
-	tierNames[1]: 1,
-	tierNames[2]: 2,
-	tierNames[3]: 3,
+	"standard": TierStandard,
+	"premium":  TierPremium,
+	"vip":      TierVIP,
 }
 
-// ParseTier parses a string into Tier type.
+// ParseTier parses a name or alias into Tier.
 func ParseTier(name string) (Tier, error) {
 	if x, ok := tierValue[name]; ok {
 		return x, nil
@@ -59,33 +55,35 @@ func ParseTier(name string) (Tier, error) {
 	return TierNull, fmt.Errorf("%s is not a valid Tier", name)
 }
 
+// String returns the canonical name, or empty string for zero value.
 func (x Tier) String() string {
-	if s, ok := tierMap[x]; ok {
-		return s
+	if x <= TierNull || x > TierVIP {
+		return ""
 	}
 
-	return ""
+	return tierNames[x]
 }
 
-// StringCN output tier as Chinese text
+// StringCN outputs Tier as Chinese text.
 func (x Tier) StringCN() string {
-	if x < TierStandard || x > TierVIP {
+	if x <= TierNull || x > TierVIP {
 		return ""
 	}
 
-	return tiersCN[x]
+	return tierCN[x]
 }
 
-// StringEN output tier as English text
+// StringEN outputs Tier as English text.
 func (x Tier) StringEN() string {
-	if x < TierStandard || x > TierPremium {
+	if x <= TierNull || x > TierVIP {
 		return ""
 	}
 
-	return tiersEN[x]
+	return tierEN[x]
 }
 
 // UnmarshalJSON implements the Unmarshaler interface.
+// Unknown names are turned into zero value.
 func (x *Tier) UnmarshalJSON(b []byte) error {
 	var s string
 	if err := json.Unmarshal(b, &s); err != nil {
@@ -99,7 +97,8 @@ func (x *Tier) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the Marshaler interface
+// MarshalJSON implements the Marshaler interface.
+// Zero value produces null.
 func (x Tier) MarshalJSON() ([]byte, error) {
 	s := x.String()
 
@@ -110,26 +109,45 @@ func (x Tier) MarshalJSON() ([]byte, error) {
 	return []byte(`"` + s + `"`), nil
 }
 
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is turned into zero value, the same as JSON null.
+func (x *Tier) UnmarshalText(text []byte) error {
+	tmp, _ := ParseTier(string(text))
+
+	*x = tmp
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Zero value produces empty text.
+func (x Tier) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
 // Scan implements sql.Scanner interface to retrieve value from SQL.
-// SQL null will be turned into zero value TierFree.
+// SQL NULL and unknown names are turned into zero value.
 func (x *Tier) Scan(src interface{}) error {
-	if src == nil {
-		*x = TierNull
-		return nil
-	}
-
-	switch s := src.(type) {
+	var s string
+	switch v := src.(type) {
+	case nil:
 	case []byte:
-		tmp, _ := ParseTier(string(s))
-		*x = tmp
-		return nil
-
+		s = string(v)
+	case string:
+		s = v
 	default:
 		return ErrIncompatible
 	}
+
+	tmp, _ := ParseTier(s)
+
+	*x = tmp
+
+	return nil
 }
 
 // Value implements driver.Valuer interface to save value into SQL.
+// Zero value is saved as NULL.
 func (x Tier) Value() (driver.Value, error) {
 	s := x.String()
 	if s == "" {
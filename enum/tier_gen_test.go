@@ -0,0 +1,146 @@
+// Code generated by enumgen from enums.json. DO NOT EDIT.
+
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTier_Generated(t *testing.T) {
+	tests := []struct {
+		name string
+		x    Tier
+		want string
+	}{
+		{
+			name: "Standard",
+			x:    TierStandard,
+			want: "standard",
+		},
+		{
+			name: "Premium",
+			x:    TierPremium,
+			want: "premium",
+		},
+		{
+			name: "VIP",
+			x:    TierVIP,
+			want: "vip",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("Tier.String() = %v, want %v", got, tt.want)
+			}
+
+			parsed, err := ParseTier(tt.want)
+			if err != nil || parsed != tt.x {
+				t.Errorf("ParseTier() = %v, %v, want %v", parsed, err, tt.x)
+			}
+
+			b, err := json.Marshal(tt.x)
+			if err != nil || string(b) != `"`+tt.want+`"` {
+				t.Errorf("Tier.MarshalJSON() = %s, %v", b, err)
+			}
+
+			var fromJSON Tier
+			if err := json.Unmarshal(b, &fromJSON); err != nil || fromJSON != tt.x {
+				t.Errorf("Tier.UnmarshalJSON() = %v, %v, want %v", fromJSON, err, tt.x)
+			}
+
+			text, _ := tt.x.MarshalText()
+			var fromText Tier
+			if err := fromText.UnmarshalText(text); err != nil || fromText != tt.x {
+				t.Errorf("Tier.UnmarshalText() = %v, %v, want %v", fromText, err, tt.x)
+			}
+
+			v, err := tt.x.Value()
+			if err != nil || v != tt.want {
+				t.Errorf("Tier.Value() = %v, %v, want %v", v, err, tt.want)
+			}
+
+			var fromSQL Tier
+			if err := fromSQL.Scan([]byte(tt.want)); err != nil || fromSQL != tt.x {
+				t.Errorf("Tier.Scan() = %v, %v, want %v", fromSQL, err, tt.x)
+			}
+		})
+	}
+}
+
+func TestTier_GeneratedZero(t *testing.T) {
+	if s := TierNull.String(); s != "" {
+		t.Errorf("Tier.String() of zero = %q", s)
+	}
+
+	if b, _ := json.Marshal(TierNull); string(b) != "null" {
+		t.Errorf("Tier.MarshalJSON() of zero = %s", b)
+	}
+
+	if v, _ := TierNull.Value(); v != nil {
+		t.Errorf("Tier.Value() of zero = %v", v)
+	}
+
+	if _, err := ParseTier("not-a-tier"); err == nil {
+		t.Error("ParseTier() of unknown name should fail")
+	}
+
+	x := TierStandard
+	if err := json.Unmarshal([]byte(`"not-a-tier"`), &x); err != nil || x != TierNull {
+		t.Errorf("Tier.UnmarshalJSON() of unknown = %v, %v", x, err)
+	}
+
+	for _, src := range []interface{}{nil, []byte{}, ""} {
+		x = TierStandard
+		if err := x.Scan(src); err != nil || x != TierNull {
+			t.Errorf("Tier.Scan(%#v) = %v, %v", src, x, err)
+		}
+	}
+
+	x = TierStandard
+	if err := x.Scan([]byte("not-a-tier")); err != nil || x != TierNull {
+		t.Errorf("Tier.Scan() of unknown = %v, %v", x, err)
+	}
+
+	if err := x.Scan(1); err != ErrIncompatible {
+		t.Errorf("Tier.Scan() of int = %v", err)
+	}
+}
+
+func TestTier_GeneratedLabels(t *testing.T) {
+	tests := []struct {
+		x  Tier
+		cn string
+		en string
+	}{
+		{
+			x: TierNull,
+		},
+		{
+			x:  TierStandard,
+			cn: "标准会员",
+			en: "Standard",
+		},
+		{
+			x:  TierPremium,
+			cn: "高级会员",
+			en: "Premium",
+		},
+		{
+			x:  TierVIP,
+			cn: "VIP",
+			en: "VIP",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.x.String(), func(t *testing.T) {
+			if got := tt.x.StringCN(); got != tt.cn {
+				t.Errorf("Tier.StringCN() = %v, want %v", got, tt.cn)
+			}
+			if got := tt.x.StringEN(); got != tt.en {
+				t.Errorf("Tier.StringEN() = %v, want %v", got, tt.en)
+			}
+		})
+	}
+}
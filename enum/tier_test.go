@@ -268,10 +268,11 @@ func TestTier_Scan(t *testing.T) {
 			wantErr: false,
 		},
 		{
+			// Unknown names are turned into TierNull, like the JSON path.
 			name:    "Invalid",
 			x:       &tier,
 			args:    args{[]byte("invalid")},
-			wantErr: true,
+			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
@@ -1,3 +1,3 @@
 module github.com/FTChinese/go-rest
 
-go 1.18
+go 1.22
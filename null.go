@@ -0,0 +1,121 @@
+package gorest
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// Null wraps a value that might be JSON null or SQL NULL,
+// following the same conventions as chrono and enum types:
+// an invalid Null is marshalled to JSON null and saved as SQL NULL.
+// If T implements json.Marshaler, json.Unmarshaler, sql.Scanner
+// or driver.Valuer, its own implementation is used for a valid value.
+type Null[T any] struct {
+	V     T
+	Valid bool
+}
+
+// NullFrom creates a valid Null.
+func NullFrom[T any](v T) Null[T] {
+	return Null[T]{
+		V:     v,
+		Valid: true,
+	}
+}
+
+// NullFromPtr creates a Null which is invalid if p is nil.
+func NullFromPtr[T any](p *T) Null[T] {
+	if p == nil {
+		return Null[T]{}
+	}
+
+	return NullFrom(*p)
+}
+
+// Ptr returns a pointer to the value, or nil if invalid.
+func (n Null[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+
+	return &n.V
+}
+
+// ValueOrZero returns the value, or zero value of T if invalid.
+func (n Null[T]) ValueOrZero() T {
+	if !n.Valid {
+		var zero T
+		return zero
+	}
+
+	return n.V
+}
+
+// MarshalJSON implements the Marshaler interface.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+// JSON null turns into an invalid Null.
+func (n *Null[T]) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		*n = Null[T]{}
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	*n = NullFrom(v)
+
+	return nil
+}
+
+// Scan implements sql.Scanner interface.
+// SQL NULL turns into an invalid Null.
+func (n *Null[T]) Scan(src interface{}) error {
+	if src == nil {
+		*n = Null[T]{}
+		return nil
+	}
+
+	var v T
+	if s, ok := interface{}(&v).(sql.Scanner); ok {
+		if err := s.Scan(src); err != nil {
+			return err
+		}
+
+		*n = NullFrom(v)
+		return nil
+	}
+
+	// Use database/sql's conversion rules for basic types.
+	var sn sql.Null[T]
+	if err := sn.Scan(src); err != nil {
+		return err
+	}
+
+	*n = NullFrom(sn.V)
+
+	return nil
+}
+
+// Value implements driver.Valuer interface.
+// Invalid Null is saved as SQL NULL.
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	// DefaultParameterConverter calls T's Value method if present.
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
+}
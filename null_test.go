@@ -0,0 +1,114 @@
+package gorest
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/FTChinese/go-rest/enum"
+)
+
+func TestNull_JSON(t *testing.T) {
+	type fields struct {
+		Name Null[string]    `json:"name"`
+		Age  Null[int64]     `json:"age"`
+		Tier Null[enum.Tier] `json:"tier"`
+	}
+
+	tests := []struct {
+		name string
+		data string
+		want fields
+	}{
+		{
+			name: "Valid",
+			data: `{"name":"foo","age":0,"tier":"premium"}`,
+			want: fields{
+				Name: NullFrom("foo"),
+				Age:  NullFrom[int64](0),
+				Tier: NullFrom(enum.TierPremium),
+			},
+		},
+		{
+			name: "Null",
+			data: `{"name":null,"age":null,"tier":null}`,
+			want: fields{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got fields
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Null.UnmarshalJSON() = %+v, want %+v", got, tt.want)
+			}
+
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.data {
+				t.Errorf("Null.MarshalJSON() = %s, want %s", b, tt.data)
+			}
+		})
+	}
+}
+
+func TestNull_Scan(t *testing.T) {
+	var s Null[string]
+	if err := s.Scan([]byte("foo")); err != nil || s != NullFrom("foo") {
+		t.Errorf("Null[string].Scan() = %v, %v", s, err)
+	}
+
+	var n Null[int64]
+	if err := n.Scan("42"); err != nil || n != NullFrom[int64](42) {
+		t.Errorf("Null[int64].Scan() = %v, %v", n, err)
+	}
+
+	var c Null[enum.Cycle]
+	if err := c.Scan([]byte("year")); err != nil || c != NullFrom(enum.CycleYear) {
+		t.Errorf("Null[enum.Cycle].Scan() = %v, %v", c, err)
+	}
+
+	if err := c.Scan(nil); err != nil || c.Valid {
+		t.Errorf("Null[enum.Cycle].Scan(nil) = %v, %v", c, err)
+	}
+}
+
+func TestNull_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		value driver.Valuer
+		want  driver.Value
+	}{
+		{
+			name:  "Invalid",
+			value: Null[string]{},
+			want:  nil,
+		},
+		{
+			name:  "Int",
+			value: NullFrom(int32(5)),
+			want:  int64(5),
+		},
+		{
+			name:  "Enum",
+			value: NullFrom(enum.CycleMonth),
+			want:  "month",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Null.Value() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
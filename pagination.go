@@ -1,6 +1,166 @@
 package gorest
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/FTChinese/go-rest/render"
+)
+
+// PaginationPolicy sets the limits of pagination for an endpoint.
+type PaginationPolicy struct {
+	DefaultLimit int64 // Used when client does not specify how many items per page.
+	MaxLimit     int64 // The largest number of items per page. 0 means no limit.
+	// Strict tells whether invalid values should be rejected.
+	// If false, invalid values are silently corrected.
+	Strict bool
+}
+
+// DefaultPaginationPolicy is a reasonable policy for new endpoints.
+var DefaultPaginationPolicy = PaginationPolicy{
+	DefaultLimit: 20,
+	MaxLimit:     100,
+}
+
+// legacyPaginationPolicy is used by GetPagination and Normalize,
+// which never capped items per page.
+var legacyPaginationPolicy = PaginationPolicy{
+	DefaultLimit: 20,
+}
+
+// invalidPagination creates a 400 response for a pagination field.
+func invalidPagination(field, msg string) *render.ResponseError {
+	re := render.NewBadRequest(msg)
+	re.Invalid = &render.ValidationError{
+		Message: msg,
+		Field:   field,
+		Code:    render.CodeInvalid,
+	}
+
+	return re
+}
+
+// checkPage validates or corrects page number.
+func (pp PaginationPolicy) checkPage(page int64) (int64, *render.ResponseError) {
+	if page >= 1 {
+		return page, nil
+	}
+
+	if pp.Strict {
+		return 0, invalidPagination("page", "page must be a positive integer")
+	}
+
+	return 1, nil
+}
+
+// checkLimit validates or corrects items per page.
+func (pp PaginationPolicy) checkLimit(limit int64) (int64, *render.ResponseError) {
+	if limit < 1 {
+		if pp.Strict {
+			return 0, invalidPagination("per_page", "per_page must be a positive integer")
+		}
+
+		return pp.DefaultLimit, nil
+	}
+
+	if pp.MaxLimit > 0 && limit > pp.MaxLimit {
+		if pp.Strict {
+			return 0, invalidPagination("per_page", fmt.Sprintf("per_page must not exceed %d", pp.MaxLimit))
+		}
+
+		return pp.MaxLimit, nil
+	}
+
+	return limit, nil
+}
+
+// queryInt reads an integer from query parameter.
+// Missing value is returned as def.
+func (pp PaginationPolicy) queryInt(req *http.Request, key string, def int64) (int64, *render.ResponseError) {
+	p := GetQueryParam(req, key)
+	if p.value == "" {
+		return def, nil
+	}
+
+	n, err := p.ToInt()
+	if err != nil {
+		if pp.Strict {
+			return 0, invalidPagination(key, key+" must be an integer")
+		}
+
+		return def, nil
+	}
+
+	return n, nil
+}
+
+// Check applies the policy to a Pagination, usually bound from JSON.
+func (pp PaginationPolicy) Check(p Pagination) (Pagination, *render.ResponseError) {
+	page, re := pp.checkPage(p.Page)
+	if re != nil {
+		return p, re
+	}
+
+	limit, re := pp.checkLimit(p.Limit)
+	if re != nil {
+		return p, re
+	}
+
+	return Pagination{
+		Page:  page,
+		Limit: limit,
+	}, nil
+}
+
+// GetPagination extracts pagination information from query parameter
+// and applies the policy.
+// A missing page is treated as the first page.
+func (pp PaginationPolicy) GetPagination(req *http.Request) (Pagination, *render.ResponseError) {
+	page, re := pp.queryInt(req, "page", 1)
+	if re != nil {
+		return Pagination{}, re
+	}
+
+	limit, re := pp.queryInt(req, "per_page", pp.DefaultLimit)
+	if re != nil {
+		return Pagination{}, re
+	}
+
+	return pp.Check(Pagination{
+		Page:  page,
+		Limit: limit,
+	})
+}
+
+// CheckCursor applies the policy to a CursorPagination.
+func (pp PaginationPolicy) CheckCursor(p CursorPagination) (CursorPagination, *render.ResponseError) {
+	limit, re := pp.checkLimit(p.Limit)
+	if re != nil {
+		return p, re
+	}
+
+	p.Limit = limit
+	if p.After != "" {
+		p.Before = ""
+	}
+
+	return p, nil
+}
+
+// GetCursorPagination extracts keyset pagination information from query parameter
+// and applies the policy.
+func (pp PaginationPolicy) GetCursorPagination(req *http.Request) (CursorPagination, *render.ResponseError) {
+	limit, re := pp.queryInt(req, "per_page", pp.DefaultLimit)
+	if re != nil {
+		return CursorPagination{}, re
+	}
+
+	return pp.CheckCursor(CursorPagination{
+		After:  GetQueryParam(req, "after").value,
+		Before: GetQueryParam(req, "before").value,
+		Limit:  limit,
+	})
+}
 
 // NewPagination creates a new Pagination instance.
 // p is the page number, r is the rows to retrieve.
@@ -21,14 +181,11 @@ type Pagination struct {
 	Limit int64 `query:"per_page" schema:"per_page" json:"limit"` // How many items per page.
 }
 
+// Normalize corrects invalid values.
+// Items per page default to 20 and are not capped;
+// use PaginationPolicy.Check to enforce a limit.
 func (p *Pagination) Normalize() {
-	if p.Page < 1 {
-		p.Page = 1
-	}
-
-	if p.Limit < 1 {
-		p.Limit = 20
-	}
+	*p, _ = legacyPaginationPolicy.Check(*p)
 }
 
 // Offset calculate the offset for SQL.
@@ -36,13 +193,19 @@ func (p Pagination) Offset() int64 {
 	return (p.Page - 1) * p.Limit
 }
 
-// GetPagination extracts pagination information from query parameter
-func GetPagination(req *http.Request) Pagination {
-	page, _ := GetQueryParam(req, "page").ToInt()
-	perPage, err := GetQueryParam(req, "per_page").ToInt()
-	if err != nil {
-		perPage = 20
+// TotalPages calculates how many pages are needed to hold total items.
+func (p Pagination) TotalPages(total int64) int64 {
+	if p.Limit < 1 || total < 1 {
+		return 0
 	}
 
-	return NewPagination(page, perPage)
+	return (total + p.Limit - 1) / p.Limit
+}
+
+// GetPagination extracts pagination information from query parameter.
+// Items per page default to 20 and are not capped;
+// use PaginationPolicy.GetPagination to enforce a limit.
+func GetPagination(req *http.Request) Pagination {
+	p, _ := legacyPaginationPolicy.GetPagination(req)
+	return p
 }
@@ -0,0 +1,130 @@
+package gorest
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPaginationPolicy_GetPagination(t *testing.T) {
+	lenient := PaginationPolicy{
+		DefaultLimit: 20,
+		MaxLimit:     50,
+	}
+	strict := lenient
+	strict.Strict = true
+
+	tests := []struct {
+		name      string
+		policy    PaginationPolicy
+		query     string
+		want      Pagination
+		wantField string
+	}{
+		{
+			name:   "Defaults",
+			policy: lenient,
+			query:  "",
+			want:   Pagination{Page: 1, Limit: 20},
+		},
+		{
+			name:   "Lenient clamps limit",
+			policy: lenient,
+			query:  "page=3&per_page=100000",
+			want:   Pagination{Page: 3, Limit: 50},
+		},
+		{
+			name:   "Lenient corrects page",
+			policy: lenient,
+			query:  "page=-2&per_page=abc",
+			want:   Pagination{Page: 1, Limit: 20},
+		},
+		{
+			name:      "Strict rejects limit",
+			policy:    strict,
+			query:     "per_page=100000",
+			wantField: "per_page",
+		},
+		{
+			name:      "Strict rejects page",
+			policy:    strict,
+			query:     "page=0",
+			wantField: "page",
+		},
+		{
+			name:   "Lenient defaults zero limit",
+			policy: lenient,
+			query:  "per_page=0",
+			want:   Pagination{Page: 1, Limit: 20},
+		},
+		{
+			name:      "Strict rejects zero limit",
+			policy:    strict,
+			query:     "per_page=0",
+			wantField: "per_page",
+		},
+		{
+			name:   "Strict defaults missing limit",
+			policy: strict,
+			query:  "page=2",
+			want:   Pagination{Page: 2, Limit: 20},
+		},
+		{
+			name:      "Strict rejects non-integer",
+			policy:    strict,
+			query:     "page=abc",
+			wantField: "page",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/?"+tt.query, nil)
+			_ = req.ParseForm()
+
+			got, re := tt.policy.GetPagination(req)
+			if tt.wantField != "" {
+				if re == nil || re.StatusCode != 400 || re.Invalid.Field != tt.wantField {
+					t.Errorf("GetPagination() error = %v, want invalid %s", re, tt.wantField)
+				}
+				return
+			}
+			if re != nil {
+				t.Fatal(re)
+			}
+			if got != tt.want {
+				t.Errorf("GetPagination() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetPagination_NoCap(t *testing.T) {
+	req := httptest.NewRequest("GET", "/?page=2&per_page=500", nil)
+
+	if got := GetPagination(req); got != (Pagination{Page: 2, Limit: 500}) {
+		t.Errorf("GetPagination() = %v", got)
+	}
+
+	p := Pagination{Page: 0, Limit: 500}
+	p.Normalize()
+	if p != (Pagination{Page: 1, Limit: 500}) {
+		t.Errorf("Pagination.Normalize() = %v", p)
+	}
+}
+
+func TestPagination_TotalPages(t *testing.T) {
+	tests := []struct {
+		total int64
+		want  int64
+	}{
+		{total: 0, want: 0},
+		{total: 1, want: 1},
+		{total: 20, want: 1},
+		{total: 21, want: 2},
+	}
+	p := NewPagination(1, 20)
+	for _, tt := range tests {
+		if got := p.TotalPages(tt.total); got != tt.want {
+			t.Errorf("Pagination.TotalPages(%d) = %d, want %d", tt.total, got, tt.want)
+		}
+	}
+}
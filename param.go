@@ -22,13 +22,25 @@ func NewParam(key, value string) Param {
 }
 
 // GetQueryParam get a pair of query parameter from URL.
+// Request body is never read, even for POST and PUT;
+// use GetFormParam for body fields.
+// Malformed pairs are ignored since whatever parsed are still usable.
 func GetQueryParam(req *http.Request, key string) Param {
-	v := req.Form.Get(key)
+	return NewParam(key, req.URL.Query().Get(key))
+}
 
-	return Param{
-		key:   key,
-		value: strings.TrimSpace(v),
-	}
+// GetFormParam gets a field of POST, PUT or PATCH request body.
+// Query parameters are ignored.
+func GetFormParam(req *http.Request, key string) Param {
+	// PostFormValue parses the body if necessary.
+	return NewParam(key, req.PostFormValue(key))
+}
+
+// GetPathParam gets a named segment of URL path using PathParamFunc.
+// Libraries should prefer PathParamExtractor.Param
+// with an extractor passed in by the caller.
+func GetPathParam(req *http.Request, key string) Param {
+	return PathParamFunc.Param(req, key)
 }
 
 // ToBool converts a query parameter to boolean value.
@@ -0,0 +1,99 @@
+package gorest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetQueryParam(t *testing.T) {
+	// Form is not parsed beforehand.
+	req := httptest.NewRequest("GET", "/?page=%202%20", nil)
+
+	got, err := GetQueryParam(req, "page").ToInt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 2 {
+		t.Errorf("GetQueryParam() = %d, want 2", got)
+	}
+}
+
+func TestGetQueryParam_IgnoreBody(t *testing.T) {
+	req := httptest.NewRequest("POST", "/?name=query", strings.NewReader("name=body"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_ = req.ParseForm()
+
+	got, _ := GetQueryParam(req, "name").ToString()
+	if got != "query" {
+		t.Errorf("GetQueryParam() = %s, want query", got)
+	}
+}
+
+func TestGetPathParam_Default(t *testing.T) {
+	var got string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orders/{id}", func(w http.ResponseWriter, req *http.Request) {
+		got, _ = GetPathParam(req, "id").ToString()
+	})
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/orders/123", nil))
+
+	if got != "123" {
+		t.Errorf("GetPathParam() = %s, want 123", got)
+	}
+}
+
+func TestGetFormParam(t *testing.T) {
+	req := httptest.NewRequest("POST", "/?name=query", strings.NewReader("name=body"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	got, _ := GetFormParam(req, "name").ToString()
+	if got != "body" {
+		t.Errorf("GetFormParam() = %s, want body", got)
+	}
+}
+
+func TestGetPathParam(t *testing.T) {
+	vars := map[string]string{"id": "from-vars"}
+
+	tests := []struct {
+		name    string
+		extract PathParamExtractor
+		want    string
+	}{
+		{
+			name:    "ServeMux",
+			extract: ServeMuxPathParam,
+			want:    "123",
+		},
+		{
+			name: "Chi",
+			extract: ChiPathParam(func(r *http.Request, key string) string {
+				return "from-" + key
+			}),
+			want: "from-id",
+		},
+		{
+			name: "Gorilla",
+			extract: MuxPathParam(func(r *http.Request) map[string]string {
+				return vars
+			}),
+			want: "from-vars",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			mux := http.NewServeMux()
+			mux.HandleFunc("/orders/{id}", func(w http.ResponseWriter, req *http.Request) {
+				got, _ = tt.extract.Param(req, "id").ToString()
+			})
+			mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/orders/123", nil))
+
+			if got != tt.want {
+				t.Errorf("GetPathParam() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
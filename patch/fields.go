@@ -0,0 +1,193 @@
+package patch
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// field is a struct field visible to encoding/json.
+type field struct {
+	name   string // Name in JSON.
+	column string // Name in SQL: the db tag, or name if not tagged.
+	index  []int  // Index sequence for reflect.Value.FieldByIndex.
+	tagged bool   // Whether name comes from the json tag.
+}
+
+// jsonFields returns the fields of struct type t the way encoding/json
+// sees them: unexported and `json:"-"` fields are skipped,
+// untagged embedded structs are flattened, and when several fields
+// share a name the shallowest wins, preferring a tagged one;
+// otherwise all of them are dropped.
+// The order follows the declaration of struct fields.
+func jsonFields(t reflect.Type) []field {
+	var all []field
+	collectFields(t, nil, &all)
+
+	byName := make(map[string][]field)
+	for _, f := range all {
+		byName[f.name] = append(byName[f.name], f)
+	}
+
+	var fields []field
+	for _, f := range all {
+		if w, ok := dominant(byName[f.name]); ok && sameIndex(w.index, f.index) {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+func collectFields(t reflect.Type, index []int, out *[]field) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		idx := append(append([]int(nil), index...), i)
+
+		if sf.Anonymous && name == "" {
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				collectFields(ft, idx, out)
+				continue
+			}
+		}
+
+		if !sf.IsExported() {
+			continue
+		}
+
+		f := field{
+			name:   name,
+			column: strings.Split(sf.Tag.Get("db"), ",")[0],
+			index:  idx,
+			tagged: name != "",
+		}
+		if f.name == "" {
+			f.name = sf.Name
+		}
+		if f.column == "" || f.column == "-" {
+			f.column = f.name
+		}
+
+		*out = append(*out, f)
+	}
+}
+
+// dominant returns the field that encoding/json uses
+// among fields of the same name, or false if ambiguous.
+func dominant(fields []field) (field, bool) {
+	depth := len(fields[0].index)
+	for _, f := range fields {
+		if len(f.index) < depth {
+			depth = len(f.index)
+		}
+	}
+
+	var win field
+	var count, tagged int
+	for _, f := range fields {
+		if len(f.index) != depth {
+			continue
+		}
+		count++
+		if f.tagged {
+			tagged++
+			win = f
+		} else if count == 1 {
+			win = f
+		}
+	}
+
+	if count > 1 && tagged != 1 {
+		return field{}, false
+	}
+
+	return win, true
+}
+
+func sameIndex(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchField finds the field a JSON key decodes into,
+// preferring an exact match over a case-insensitive one
+// as json.Unmarshal does.
+func matchField(fields []field, key string) (field, bool) {
+	for _, f := range fields {
+		if f.name == key {
+			return f, true
+		}
+	}
+
+	for _, f := range fields {
+		if strings.EqualFold(f.name, key) {
+			return f, true
+		}
+	}
+
+	return field{}, false
+}
+
+var (
+	unmarshalerType     = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// isUnmarshaler tests whether encoding/json decodes t by a method
+// instead of field by field.
+func isUnmarshaler(t reflect.Type) bool {
+	pt := reflect.PointerTo(t)
+	return pt.Implements(unmarshalerType) || pt.Implements(textUnmarshalerType)
+}
+
+// resetJSONFields sets the fields of struct v visible to encoding/json
+// to zero value, so that decoding a full JSON representation into v
+// produces the same result as decoding into a new value,
+// except that fields hidden from JSON are kept.
+// Nested structs are reset field by field for the same reason;
+// pointers are reset to nil.
+func resetJSONFields(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		embedded := sf.Anonymous && strings.Split(tag, ",")[0] == ""
+		if !sf.IsExported() && !embedded {
+			continue
+		}
+
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Struct && !isUnmarshaler(fv.Type()) {
+			resetJSONFields(fv)
+			continue
+		}
+
+		if fv.CanSet() {
+			fv.Set(reflect.Zero(fv.Type()))
+		}
+	}
+}
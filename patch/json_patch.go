@@ -0,0 +1,206 @@
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Operation is a single operation of a JSON Patch document.
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// JSONPatch is a sequence of operations defined in RFC 6902.
+type JSONPatch []Operation
+
+// ParseJSONPatch parses a JSON Patch document.
+func ParseJSONPatch(data []byte) (JSONPatch, error) {
+	var p JSONPatch
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (o Operation) value() (interface{}, error) {
+	if o.Value == nil {
+		return nil, fmt.Errorf("patch: %s operation requires a value", o.Op)
+	}
+
+	return decode(o.Value)
+}
+
+// add inserts value into the location referenced by tokens.
+func add(doc interface{}, tokens []string, value interface{}) (interface{}, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+
+	return update(doc, tokens, func(parent interface{}, token string) (interface{}, error) {
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			p[token] = value
+			return p, nil
+
+		case []interface{}:
+			if token == "-" {
+				return append(p, value), nil
+			}
+			// Index equal to length is allowed to append.
+			i, err := arrayIndex(token, len(p)+1)
+			if err != nil {
+				return nil, err
+			}
+			p = append(p, nil)
+			copy(p[i+1:], p[i:])
+			p[i] = value
+			return p, nil
+
+		default:
+			return nil, fmt.Errorf("patch: cannot add %q to a scalar value", token)
+		}
+	})
+}
+
+// remove deletes the value referenced by tokens.
+func remove(doc interface{}, tokens []string) (interface{}, error) {
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("patch: cannot remove the whole document")
+	}
+
+	return update(doc, tokens, func(parent interface{}, token string) (interface{}, error) {
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			if _, ok := p[token]; !ok {
+				return nil, fmt.Errorf("patch: member %q not found", token)
+			}
+			delete(p, token)
+			return p, nil
+
+		case []interface{}:
+			i, err := arrayIndex(token, len(p))
+			if err != nil {
+				return nil, err
+			}
+			return append(p[:i], p[i+1:]...), nil
+
+		default:
+			return nil, fmt.Errorf("patch: cannot remove %q from a scalar value", token)
+		}
+	})
+}
+
+// Apply applies the operations in order to a JSON document.
+// If any operation fails, the whole patch is aborted.
+func (p JSONPatch) Apply(data []byte) ([]byte, error) {
+	doc, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range p {
+		doc, err = o.apply(doc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(doc)
+}
+
+func (o Operation) apply(doc interface{}) (interface{}, error) {
+	path, err := parsePointer(o.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch o.Op {
+	case "add":
+		v, err := o.value()
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, v)
+
+	case "remove":
+		return remove(doc, path)
+
+	case "replace":
+		v, err := o.value()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := get(doc, path); err != nil {
+			return nil, err
+		}
+		if len(path) > 0 {
+			if doc, err = remove(doc, path); err != nil {
+				return nil, err
+			}
+		}
+		return add(doc, path, v)
+
+	case "move":
+		if o.From == o.Path {
+			return doc, nil
+		}
+		if strings.HasPrefix(o.Path, o.From+"/") {
+			return nil, fmt.Errorf("patch: cannot move %q into its own child", o.From)
+		}
+		from, err := parsePointer(o.From)
+		if err != nil {
+			return nil, err
+		}
+		v, err := get(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		if doc, err = remove(doc, from); err != nil {
+			return nil, err
+		}
+		return add(doc, path, v)
+
+	case "copy":
+		from, err := parsePointer(o.From)
+		if err != nil {
+			return nil, err
+		}
+		v, err := get(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, deepCopy(v))
+
+	case "test":
+		want, err := o.value()
+		if err != nil {
+			return nil, err
+		}
+		got, err := get(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !equal(got, want) {
+			return nil, fmt.Errorf("patch: test failed at %q", o.Path)
+		}
+		return doc, nil
+
+	default:
+		return nil, fmt.Errorf("patch: unknown operation %q", o.Op)
+	}
+}
+
+// ApplyJSONPatch applies a JSON Patch document to the value pointed to by v.
+func ApplyJSONPatch(v interface{}, patch []byte) error {
+	p, err := ParseJSONPatch(patch)
+	if err != nil {
+		return err
+	}
+
+	return apply(v, p.Apply)
+}
@@ -0,0 +1,111 @@
+// Package patch applies JSON Merge Patch (RFC 7396) and JSON Patch (RFC 6902)
+// documents, and tells which fields a PATCH request body explicitly set,
+// nulled or omitted.
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// decode parses JSON into generic values.
+// Numbers are kept as json.Number to avoid losing precision.
+func decode(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// merge implements the MergePatch function defined in RFC 7396.
+func merge(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = map[string]interface{}{}
+	}
+
+	for name, value := range p {
+		if value == nil {
+			delete(t, name)
+			continue
+		}
+
+		t[name] = merge(t[name], value)
+	}
+
+	return t
+}
+
+// MergePatch applies a JSON merge patch to a JSON document.
+func MergePatch(doc, patch []byte) ([]byte, error) {
+	target, err := decode(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := decode(patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(merge(target, p))
+}
+
+// apply converts v to JSON, transforms it with fn,
+// and decodes the result back into v.
+// If v points to a struct, fields hidden from JSON,
+// like unexported ones and those tagged `json:"-"`, are kept;
+// every other field takes its value from the transformed document,
+// and is reset to zero if the document removed it.
+func apply(v interface{}, fn func(doc []byte) ([]byte, error)) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("patch: target must be a non-nil pointer")
+	}
+
+	doc, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	doc, err = fn(doc)
+	if err != nil {
+		return err
+	}
+
+	// Decode into a copy so that v is untouched on error.
+	fresh := reflect.New(rv.Elem().Type())
+	if t := rv.Elem().Type(); t.Kind() == reflect.Struct && !isUnmarshaler(t) {
+		fresh.Elem().Set(rv.Elem())
+		resetJSONFields(fresh.Elem())
+	}
+
+	if err := json.Unmarshal(doc, fresh.Interface()); err != nil {
+		return err
+	}
+
+	rv.Elem().Set(fresh.Elem())
+
+	return nil
+}
+
+// ApplyMergePatch applies a JSON merge patch to the value pointed to by v.
+// A null in the patch resets the field to zero value,
+// which for chrono and enum types means SQL NULL.
+func ApplyMergePatch(v interface{}, patch []byte) error {
+	return apply(v, func(doc []byte) ([]byte, error) {
+		return MergePatch(doc, patch)
+	})
+}
@@ -0,0 +1,116 @@
+package patch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/FTChinese/go-rest/chrono"
+	"github.com/FTChinese/go-rest/enum"
+)
+
+func TestMergePatch(t *testing.T) {
+	// Examples from RFC 7396 Appendix A.
+	tests := []struct {
+		doc   string
+		patch string
+		want  string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.patch, func(t *testing.T) {
+			got, err := MergePatch([]byte(tt.doc), []byte(tt.patch))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MergePatch() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+type profile struct {
+	Name     string      `json:"name"`
+	Birthday chrono.Date `json:"birthday"`
+	Gender   enum.Gender `json:"gender"`
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	p := profile{
+		Name:     "Foo",
+		Birthday: chrono.DateFrom(chrono.TimeNow().Time),
+		Gender:   enum.GenderFemale,
+	}
+
+	err := ApplyMergePatch(&p, []byte(`{"name":null,"birthday":null}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Name != "" || !p.Birthday.IsZero() || p.Gender != enum.GenderFemale {
+		b, _ := json.Marshal(p)
+		t.Errorf("ApplyMergePatch() = %s", b)
+	}
+}
+
+type account struct {
+	ID      string `json:"-"`
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	version int
+	Address struct {
+		City   string `json:"city"`
+		Street string `json:"street"`
+		geo    string
+	} `json:"address"`
+}
+
+func TestApplyMergePatch_KeepHidden(t *testing.T) {
+	a := account{
+		ID:      "abc",
+		Name:    "Foo",
+		Email:   "foo@example.org",
+		version: 3,
+	}
+	a.Address.City = "Beijing"
+	a.Address.Street = "Chang'an"
+	a.Address.geo = "39.9,116.4"
+
+	err := ApplyMergePatch(&a, []byte(`{"name":"bar","email":null,"address":{"street":null}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.ID != "abc" || a.version != 3 || a.Address.geo != "39.9,116.4" {
+		t.Errorf("ApplyMergePatch() lost hidden fields: %+v", a)
+	}
+	if a.Name != "bar" || a.Email != "" || a.Address.City != "Beijing" || a.Address.Street != "" {
+		t.Errorf("ApplyMergePatch() = %+v", a)
+	}
+}
+
+func TestApplyMergePatch_Error(t *testing.T) {
+	a := account{ID: "abc", Name: "Foo"}
+
+	if err := ApplyMergePatch(&a, []byte(`{"name":1}`)); err == nil {
+		t.Fatal("ApplyMergePatch() should fail on type mismatch")
+	}
+	if a.ID != "abc" || a.Name != "Foo" {
+		t.Errorf("ApplyMergePatch() modified target on error: %+v", a)
+	}
+}
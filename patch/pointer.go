@@ -0,0 +1,177 @@
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parsePointer splits a JSON Pointer (RFC 6901) into reference tokens.
+// The empty pointer refers to the whole document and yields no tokens.
+func parsePointer(ptr string) ([]string, error) {
+	if ptr == "" {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(ptr, "/") {
+		return nil, fmt.Errorf("patch: invalid pointer %q", ptr)
+	}
+
+	tokens := strings.Split(ptr[1:], "/")
+	for i, t := range tokens {
+		t = strings.ReplaceAll(t, "~1", "/")
+		tokens[i] = strings.ReplaceAll(t, "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+// arrayIndex parses a reference token used on an array.
+// Leading zeros are not allowed.
+func arrayIndex(token string, length int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("patch: invalid array index %q", token)
+	}
+
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || i >= length {
+		return 0, fmt.Errorf("patch: array index %q out of range", token)
+	}
+
+	return i, nil
+}
+
+// child gets the member of a container referenced by token.
+func child(doc interface{}, token string) (interface{}, error) {
+	switch c := doc.(type) {
+	case map[string]interface{}:
+		v, ok := c[token]
+		if !ok {
+			return nil, fmt.Errorf("patch: member %q not found", token)
+		}
+		return v, nil
+
+	case []interface{}:
+		i, err := arrayIndex(token, len(c))
+		if err != nil {
+			return nil, err
+		}
+		return c[i], nil
+
+	default:
+		return nil, fmt.Errorf("patch: cannot reference %q in a scalar value", token)
+	}
+}
+
+// get finds the value referenced by tokens.
+func get(doc interface{}, tokens []string) (interface{}, error) {
+	for _, t := range tokens {
+		var err error
+		doc, err = child(doc, t)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
+// update walks to the parent of the value referenced by tokens
+// and replaces the parent with the result of fn.
+// Since appending to a slice may allocate a new one,
+// every container on the path is written back.
+func update(doc interface{}, tokens []string, fn func(parent interface{}, token string) (interface{}, error)) (interface{}, error) {
+	if len(tokens) == 1 {
+		return fn(doc, tokens[0])
+	}
+
+	c, err := child(doc, tokens[0])
+	if err != nil {
+		return nil, err
+	}
+
+	c, err = update(c, tokens[1:], fn)
+	if err != nil {
+		return nil, err
+	}
+
+	switch p := doc.(type) {
+	case map[string]interface{}:
+		p[tokens[0]] = c
+	case []interface{}:
+		// Index is already validated by child.
+		i, _ := strconv.Atoi(tokens[0])
+		p[i] = c
+	}
+
+	return doc, nil
+}
+
+// deepCopy copies a value decoded from JSON.
+func deepCopy(v interface{}) interface{} {
+	switch c := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(c))
+		for k, e := range c {
+			m[k] = deepCopy(e)
+		}
+		return m
+
+	case []interface{}:
+		s := make([]interface{}, len(c))
+		for i, e := range c {
+			s[i] = deepCopy(e)
+		}
+		return s
+
+	default:
+		return v
+	}
+}
+
+// equal compares two values decoded from JSON.
+// Numbers are compared by value so that 1 equals 1.0.
+func equal(a, b interface{}) bool {
+	switch x := a.(type) {
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k, v := range x {
+			w, ok := y[k]
+			if !ok || !equal(v, w) {
+				return false
+			}
+		}
+		return true
+
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equal(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if x == y {
+			return true
+		}
+		fx, errX := x.Float64()
+		fy, errY := y.Float64()
+		return errX == nil && errY == nil && fx == fy
+
+	default:
+		return a == b
+	}
+}
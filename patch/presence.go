@@ -0,0 +1,195 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/FTChinese/go-rest/render"
+)
+
+// Presence tells how a field appears in a request body.
+type Presence int
+
+// Allowed values of Presence
+const (
+	Omitted Presence = iota // Field is not in the body.
+	Null                    // Field is explicitly set to null.
+	Set                     // Field has a non-null value.
+)
+
+var presenceNames = [...]string{
+	"omitted",
+	"null",
+	"set",
+}
+
+func (p Presence) String() string {
+	if p < Omitted || p > Set {
+		return ""
+	}
+
+	return presenceNames[p]
+}
+
+// Fields records the presence of top-level fields of a JSON object,
+// keyed by JSON field name.
+// Fields not in the map are omitted.
+type Fields map[string]Presence
+
+// Decode parses a JSON object into v like json.Unmarshal,
+// and reports which fields are present.
+// If v points to a struct, keys are matched to fields
+// the same way json.Unmarshal does, case-insensitively,
+// and recorded under the field's JSON name.
+// Keys matching no field are recorded as is.
+func Decode(data []byte, v interface{}) (Fields, error) {
+	members, err := decodeMembers(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	fields := structFields(v)
+
+	f := make(Fields, len(members))
+	for _, m := range members {
+		k := m.key
+		if sf, ok := matchField(fields, k); ok {
+			k = sf.name
+		}
+
+		// Like json.Unmarshal, the last key matching a field wins.
+		if bytes.Equal(bytes.TrimSpace(m.value), []byte("null")) {
+			f[k] = Null
+		} else {
+			f[k] = Set
+		}
+	}
+
+	return f, nil
+}
+
+type member struct {
+	key   string
+	value json.RawMessage
+}
+
+// decodeMembers splits a JSON object into its members in document order.
+func decodeMembers(data []byte) ([]member, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	// Null is a valid JSON value but has no members.
+	if raw == nil {
+		return nil, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+
+	var members []member
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		var m member
+		m.key, _ = t.(string)
+		if err := dec.Decode(&m.value); err != nil {
+			return nil, err
+		}
+
+		members = append(members, m)
+	}
+
+	return members, nil
+}
+
+// structFields returns the JSON fields of v, or nil if v is not a struct.
+func structFields(v interface{}) []field {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	return jsonFields(t)
+}
+
+// Get returns the presence of a field.
+func (f Fields) Get(name string) Presence {
+	return f[name]
+}
+
+// IsSet tests whether a field has a non-null value.
+func (f Fields) IsSet(name string) bool {
+	return f[name] == Set
+}
+
+// IsNull tests whether a field is explicitly set to null.
+func (f Fields) IsNull(name string) bool {
+	return f[name] == Null
+}
+
+// IsOmitted tests whether a field is absent.
+func (f Fields) IsOmitted(name string) bool {
+	return f[name] == Omitted
+}
+
+// Names returns the sorted names of fields that are present,
+// either set or nulled. These are the fields to update.
+func (f Fields) Names() []string {
+	names := make([]string, 0, len(f))
+	for k, p := range f {
+		if p != Omitted {
+			names = append(names, k)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Require checks that all the named fields have non-null values.
+func (f Fields) Require(names ...string) *render.ValidationError {
+	for _, name := range names {
+		if !f.IsSet(name) {
+			return &render.ValidationError{
+				Message: name + " is required",
+				Field:   name,
+				Code:    render.CodeMissingField,
+			}
+		}
+	}
+
+	return nil
+}
+
+// Columns maps the present fields to SQL columns
+// using the `db` tag of the struct v, falling back to the JSON name.
+// Fields of embedded structs are included.
+// The order follows the declaration of struct fields.
+func (f Fields) Columns(v interface{}) []string {
+	var cols []string
+	for _, sf := range structFields(v) {
+		if !f.IsOmitted(sf.name) {
+			cols = append(cols, sf.column)
+		}
+	}
+
+	return cols
+}
@@ -0,0 +1,85 @@
+package patch
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/FTChinese/go-rest/chrono"
+)
+
+type profileInput struct {
+	Name     string      `json:"name" db:"user_name"`
+	Birthday chrono.Date `json:"birthday" db:"birth_date"`
+	Bio      string      `json:"bio"`
+	ID       string      `json:"-" db:"user_id"`
+}
+
+func TestDecode(t *testing.T) {
+	var input profileInput
+	f, err := Decode([]byte(`{"name": "Foo", "birthday": null}`), &input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := f.Get("name"); got != Set {
+		t.Errorf("Fields.Get(name) = %s, want set", got)
+	}
+	if got := f.Get("birthday"); got != Null {
+		t.Errorf("Fields.Get(birthday) = %s, want null", got)
+	}
+	if got := f.Get("bio"); got != Omitted {
+		t.Errorf("Fields.Get(bio) = %s, want omitted", got)
+	}
+
+	if got := f.Names(); !reflect.DeepEqual(got, []string{"birthday", "name"}) {
+		t.Errorf("Fields.Names() = %v", got)
+	}
+
+	if got := f.Columns(input); !reflect.DeepEqual(got, []string{"user_name", "birth_date"}) {
+		t.Errorf("Fields.Columns() = %v", got)
+	}
+
+	if ve := f.Require("name"); ve != nil {
+		t.Errorf("Fields.Require(name) = %v", ve)
+	}
+	if ve := f.Require("birthday"); ve == nil || ve.Field != "birthday" {
+		t.Errorf("Fields.Require(birthday) = %v", ve)
+	}
+}
+
+type auditInput struct {
+	profileInput
+	Note string `json:"note" db:"audit_note"`
+}
+
+func TestDecode_CaseInsensitive(t *testing.T) {
+	var input auditInput
+	f, err := Decode([]byte(`{"Name": "Foo", "BIO": null, "Note": "x", "extra": 1}`), &input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if input.Name != "Foo" {
+		t.Fatalf("Decode() = %+v", input)
+	}
+
+	if got := f.Names(); !reflect.DeepEqual(got, []string{"bio", "extra", "name", "note"}) {
+		t.Errorf("Fields.Names() = %v", got)
+	}
+
+	if got := f.Columns(input); !reflect.DeepEqual(got, []string{"user_name", "bio", "audit_note"}) {
+		t.Errorf("Fields.Columns() = %v", got)
+	}
+}
+
+func TestDecode_LastKeyWins(t *testing.T) {
+	var input profileInput
+	f, err := Decode([]byte(`{"Name": null, "name": "Foo"}`), &input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if input.Name != "Foo" || !f.IsSet("name") {
+		t.Errorf("Decode() = %+v, %v", input, f)
+	}
+}
@@ -0,0 +1,44 @@
+package gorest
+
+import "net/http"
+
+// PathParamExtractor gets the value of a named segment of URL path.
+// Routers store path parameters differently,
+// so each of them needs an adapter.
+type PathParamExtractor func(req *http.Request, key string) string
+
+// Param gets a named segment of URL path as a Param.
+func (e PathParamExtractor) Param(req *http.Request, key string) Param {
+	return NewParam(key, e(req, key))
+}
+
+// PathParamFunc is used by GetPathParam to read path parameters.
+// It defaults to http.ServeMux patterns.
+// It is shared by the whole process and not guarded by a lock,
+// so set it only once, in main or init, if another router is used:
+//
+//	gorest.PathParamFunc = gorest.ChiPathParam(chi.URLParam)
+//	gorest.PathParamFunc = gorest.MuxPathParam(mux.Vars)
+//
+// Libraries and tests should not change it;
+// call PathParamExtractor.Param on their own extractor instead.
+var PathParamFunc PathParamExtractor = ServeMuxPathParam
+
+// ServeMuxPathParam reads wildcards matched by http.ServeMux patterns like /orders/{id}.
+func ServeMuxPathParam(req *http.Request, key string) string {
+	return req.PathValue(key)
+}
+
+// ChiPathParam adapts chi.URLParam so that this package
+// does not depend on chi.
+func ChiPathParam(urlParam func(r *http.Request, key string) string) PathParamExtractor {
+	return PathParamExtractor(urlParam)
+}
+
+// MuxPathParam adapts gorilla/mux's mux.Vars so that this package
+// does not depend on gorilla.
+func MuxPathParam(vars func(r *http.Request) map[string]string) PathParamExtractor {
+	return func(req *http.Request, key string) string {
+		return vars(req)[key]
+	}
+}
@@ -0,0 +1,227 @@
+package gorest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SliceFormat decides how a Slice is stored in a SQL column.
+// It is one of SliceJSON, SliceSet or SliceDelimited.
+type SliceFormat interface {
+	sliceFormat()
+}
+
+// SliceJSON stores a Slice as a JSON array, usually in a JSON column.
+type SliceJSON struct{}
+
+// SliceSet stores a Slice in MySQL SET column.
+// Elements must not contain commas.
+type SliceSet struct{}
+
+// SliceDelimited stores a Slice as comma-separated string.
+// Commas and backslashes inside elements are escaped with a backslash.
+type SliceDelimited struct{}
+
+func (SliceJSON) sliceFormat()      {}
+func (SliceSet) sliceFormat()       {}
+func (SliceDelimited) sliceFormat() {}
+
+// Slice implements Scanner and Valuer interface for a slice of T,
+// stored in the format F. For example:
+//
+//	Slice[enum.PayMethod, SliceSet]
+//
+// Elements are converted to and from strings using T's
+// Value and Scan methods if present, otherwise following
+// database/sql's conversion rules.
+// In JSON it is always an array, and nil slice is marshalled as an empty array.
+type Slice[T any, F SliceFormat] []T
+
+// MarshalJSON implements the Marshaler interface.
+func (x Slice[T, F]) MarshalJSON() ([]byte, error) {
+	if x == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]T(x))
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+// JSON null turns into an empty slice.
+func (x *Slice[T, F]) UnmarshalJSON(b []byte) error {
+	var tmp []T
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+
+	if tmp == nil {
+		tmp = []T{}
+	}
+
+	*x = tmp
+
+	return nil
+}
+
+// Scan retrieves a string value from SQL to a Go slice.
+// SQL NULL turns into an empty slice.
+func (x *Slice[T, F]) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		*x = Slice[T, F]{}
+		return nil
+
+	case []byte:
+		s = string(v)
+
+	case string:
+		s = v
+
+	default:
+		return errors.New("incompatible type to scan")
+	}
+
+	if s == "" {
+		*x = Slice[T, F]{}
+		return nil
+	}
+
+	var f F
+	if _, ok := interface{}(f).(SliceJSON); ok {
+		return x.UnmarshalJSON([]byte(s))
+	}
+
+	var parts []string
+	if _, ok := interface{}(f).(SliceDelimited); ok {
+		parts = splitEscaped(s)
+	} else {
+		parts = strings.Split(s, ",")
+	}
+
+	tmp := make(Slice[T, F], 0, len(parts))
+	for _, p := range parts {
+		e, err := parseSliceElem[T](p)
+		if err != nil {
+			return err
+		}
+		tmp = append(tmp, e)
+	}
+
+	*x = tmp
+
+	return nil
+}
+
+// Value turns a Go slice to a string.
+// Empty slice is saved as SQL NULL.
+func (x Slice[T, F]) Value() (driver.Value, error) {
+	if len(x) == 0 {
+		return nil, nil
+	}
+
+	var f F
+	if _, ok := interface{}(f).(SliceJSON); ok {
+		b, err := json.Marshal([]T(x))
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	}
+
+	_, escape := interface{}(f).(SliceDelimited)
+
+	parts := make([]string, 0, len(x))
+	for _, e := range x {
+		s, err := formatSliceElem(e)
+		if err != nil {
+			return nil, err
+		}
+
+		if escape {
+			s = escapeElem(s)
+		} else if strings.Contains(s, ",") {
+			return nil, fmt.Errorf("SET member %q must not contain commas", s)
+		}
+
+		parts = append(parts, s)
+	}
+
+	return strings.Join(parts, ","), nil
+}
+
+// formatSliceElem converts an element to string via driver value.
+func formatSliceElem(e interface{}) (string, error) {
+	v, err := driver.DefaultParameterConverter.ConvertValue(e)
+	if err != nil {
+		return "", err
+	}
+
+	switch x := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return x, nil
+	case []byte:
+		return string(x), nil
+	case int64:
+		return strconv.FormatInt(x, 10), nil
+	case float64:
+		return strconv.FormatFloat(x, 'g', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	case time.Time:
+		return x.Format(time.RFC3339Nano), nil
+	default:
+		return "", fmt.Errorf("cannot convert %T to string", v)
+	}
+}
+
+// parseSliceElem converts a string to an element.
+func parseSliceElem[T any](s string) (T, error) {
+	var v T
+	if sc, ok := interface{}(&v).(sql.Scanner); ok {
+		err := sc.Scan([]byte(s))
+		return v, err
+	}
+
+	var n sql.Null[T]
+	err := n.Scan(s)
+
+	return n.V, err
+}
+
+func escapeElem(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, ",", `\,`)
+}
+
+// splitEscaped splits a string by unescaped commas and unescapes each part.
+func splitEscaped(s string) []string {
+	var parts []string
+	var b strings.Builder
+
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			b.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ',':
+			parts = append(parts, b.String())
+			b.Reset()
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return append(parts, b.String())
+}
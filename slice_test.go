@@ -0,0 +1,115 @@
+package gorest
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/FTChinese/go-rest/enum"
+)
+
+func TestSlice_Value(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   driver.Valuer
+		want    driver.Value
+		wantErr bool
+	}{
+		{
+			name:  "Enum in SET",
+			value: Slice[enum.PayMethod, SliceSet]{enum.PayMethodAli, enum.PayMethodWx},
+			want:  "alipay,wechat",
+		},
+		{
+			name:  "Enum in JSON",
+			value: Slice[enum.PayMethod, SliceJSON]{enum.PayMethodAli, enum.PayMethodStripe},
+			want:  `["alipay","stripe"]`,
+		},
+		{
+			name:  "Int in JSON",
+			value: Slice[int, SliceJSON]{1, 2},
+			want:  `[1,2]`,
+		},
+		{
+			name:  "Escaped",
+			value: Slice[string, SliceDelimited]{`a,b`, `c\d`, "e"},
+			want:  `a\,b,c\\d,e`,
+		},
+		{
+			name:    "Comma in SET",
+			value:   Slice[string, SliceSet]{"a,b"},
+			wantErr: true,
+		},
+		{
+			name:  "Empty",
+			value: Slice[string, SliceDelimited]{},
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.Value()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Slice.Value() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Slice.Value() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlice_Scan(t *testing.T) {
+	var methods Slice[enum.PayMethod, SliceSet]
+	if err := methods.Scan("alipay,tenpay"); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(methods, Slice[enum.PayMethod, SliceSet]{enum.PayMethodAli, enum.PayMethodWx}) {
+		t.Errorf("Slice.Scan() = %v", methods)
+	}
+
+	var nums Slice[int64, SliceJSON]
+	if err := nums.Scan([]byte("[3,4]")); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(nums, Slice[int64, SliceJSON]{3, 4}) {
+		t.Errorf("Slice.Scan() = %v", nums)
+	}
+
+	var ints Slice[int64, SliceDelimited]
+	if err := ints.Scan([]byte("5,6")); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ints, Slice[int64, SliceDelimited]{5, 6}) {
+		t.Errorf("Slice.Scan() = %v", ints)
+	}
+
+	var strs Slice[string, SliceDelimited]
+	if err := strs.Scan(`a\,b,c\\d,e`); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(strs, Slice[string, SliceDelimited]{`a,b`, `c\d`, "e"}) {
+		t.Errorf("Slice.Scan() = %v", strs)
+	}
+
+	if err := strs.Scan(nil); err != nil || strs == nil || len(strs) != 0 {
+		t.Errorf("Slice.Scan(nil) = %v, %v", strs, err)
+	}
+}
+
+func TestSlice_MarshalJSON(t *testing.T) {
+	var s Slice[enum.Cycle, SliceSet]
+
+	b, _ := json.Marshal(s)
+	if string(b) != "[]" {
+		t.Errorf("Slice.MarshalJSON() = %s", b)
+	}
+
+	s = Slice[enum.Cycle, SliceSet]{enum.CycleMonth}
+	b, _ = json.Marshal(s)
+	if string(b) != `["month"]` {
+		t.Errorf("Slice.MarshalJSON() = %s", b)
+	}
+}
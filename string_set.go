@@ -0,0 +1,183 @@
+package gorest
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// StringSet is an ordered set of strings.
+// Members keep the order they are added in, so that
+// a set saved without changes produces the same SQL value.
+// It is stored in SQL as a comma-separated string like SliceDelimited,
+// so members may contain commas.
+type StringSet []string
+
+// NewStringSet creates a StringSet from items, dropping duplicates and empty strings.
+func NewStringSet(items ...string) StringSet {
+	s := make(StringSet, 0, len(items))
+	s.Add(items...)
+
+	return s
+}
+
+func (s StringSet) index() map[string]struct{} {
+	m := make(map[string]struct{}, len(s))
+	for _, v := range s {
+		m[v] = struct{}{}
+	}
+
+	return m
+}
+
+// Contains tests whether item is a member.
+func (s StringSet) Contains(item string) bool {
+	for _, v := range s {
+		if v == item {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContainsFold tests whether item is a member, ignoring case.
+func (s StringSet) ContainsFold(item string) bool {
+	for _, v := range s {
+		if strings.EqualFold(v, item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Add appends items not yet in the set.
+// Existing members keep their position.
+func (s *StringSet) Add(items ...string) {
+	m := s.index()
+	for _, item := range items {
+		if item == "" {
+			continue
+		}
+
+		if _, ok := m[item]; ok {
+			continue
+		}
+
+		m[item] = struct{}{}
+		*s = append(*s, item)
+	}
+}
+
+// Remove deletes items from the set.
+func (s *StringSet) Remove(items ...string) {
+	*s = s.Diff(NewStringSet(items...))
+}
+
+// Union returns members of either s or other.
+// Members of s come first.
+func (s StringSet) Union(other StringSet) StringSet {
+	u := NewStringSet(s...)
+	u.Add(other...)
+
+	return u
+}
+
+// Intersect returns members of s that are also in other.
+func (s StringSet) Intersect(other StringSet) StringSet {
+	m := other.index()
+	r := StringSet{}
+	for _, v := range s {
+		if _, ok := m[v]; ok {
+			r = append(r, v)
+		}
+	}
+
+	return r
+}
+
+// Diff returns members of s that are not in other.
+func (s StringSet) Diff(other StringSet) StringSet {
+	m := other.index()
+	r := StringSet{}
+	for _, v := range s {
+		if _, ok := m[v]; !ok {
+			r = append(r, v)
+		}
+	}
+
+	return r
+}
+
+// Equal tests whether two sets have the same members, regardless of order.
+func (s StringSet) Equal(other StringSet) bool {
+	if len(s) != len(other) {
+		return false
+	}
+
+	return len(s.Diff(other)) == 0
+}
+
+// Sorted returns a copy with members sorted in ascending order.
+func (s StringSet) Sorted() StringSet {
+	r := NewStringSet(s...)
+	sort.Strings(r)
+
+	return r
+}
+
+// Lower returns a copy with members turned into lower case.
+// Members differing only in case are merged.
+func (s StringSet) Lower() StringSet {
+	r := make(StringSet, 0, len(s))
+	for _, v := range s {
+		r.Add(strings.ToLower(v))
+	}
+
+	return r
+}
+
+// MarshalJSON implements the Marshaler interface.
+// Nil set is marshalled as an empty array.
+func (s StringSet) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]string(s))
+}
+
+// UnmarshalJSON implements the Unmarshaler interface.
+// Duplicates are dropped.
+func (s *StringSet) UnmarshalJSON(b []byte) error {
+	var items []string
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+
+	*s = NewStringSet(items...)
+
+	return nil
+}
+
+// Scan retrieves a comma-separated string value from SQL.
+// Escaped commas are kept inside members. Duplicates are dropped.
+func (s *StringSet) Scan(src interface{}) error {
+	var items Slice[string, SliceDelimited]
+	if err := items.Scan(src); err != nil {
+		return err
+	}
+
+	*s = NewStringSet(items...)
+
+	return nil
+}
+
+// Value turns the set to a comma-separated string in member order.
+// Commas and backslashes inside members are escaped with a backslash.
+// Empty set is saved as SQL NULL.
+func (s StringSet) Value() (driver.Value, error) {
+	return Slice[string, SliceDelimited](s).Value()
+}
@@ -0,0 +1,117 @@
+package gorest
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestStringSet(t *testing.T) {
+	s := NewStringSet("web", "ios", "web", "", "android")
+	if !reflect.DeepEqual(s, StringSet{"web", "ios", "android"}) {
+		t.Errorf("NewStringSet() = %v", s)
+	}
+
+	s.Add("ios", "wechat")
+	s.Remove("web")
+	if !reflect.DeepEqual(s, StringSet{"ios", "android", "wechat"}) {
+		t.Errorf("Add and Remove = %v", s)
+	}
+
+	other := NewStringSet("wechat", "web")
+
+	tests := []struct {
+		name string
+		got  StringSet
+		want StringSet
+	}{
+		{
+			name: "Union",
+			got:  s.Union(other),
+			want: StringSet{"ios", "android", "wechat", "web"},
+		},
+		{
+			name: "Intersect",
+			got:  s.Intersect(other),
+			want: StringSet{"wechat"},
+		},
+		{
+			name: "Diff",
+			got:  s.Diff(other),
+			want: StringSet{"ios", "android"},
+		},
+		{
+			name: "Sorted",
+			got:  s.Sorted(),
+			want: StringSet{"android", "ios", "wechat"},
+		},
+		{
+			name: "Lower",
+			got:  NewStringSet("Web", "WEB", "iOS").Lower(),
+			want: StringSet{"web", "ios"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("StringSet.%s() = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+
+	if !s.Equal(NewStringSet("wechat", "ios", "android")) {
+		t.Error("StringSet.Equal() = false for same members")
+	}
+	if !s.ContainsFold("IOS") || s.Contains("IOS") {
+		t.Error("StringSet.ContainsFold() mismatch")
+	}
+}
+
+func TestStringSet_RoundTrip(t *testing.T) {
+	s := NewStringSet("b", "a", "c")
+
+	v, err := s.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var scanned StringSet
+	if err := scanned.Scan([]byte(v.(string))); err != nil {
+		t.Fatal(err)
+	}
+
+	v2, _ := scanned.Value()
+	if v2 != v {
+		t.Errorf("StringSet SQL round trip = %v, want %v", v2, v)
+	}
+
+	b, _ := json.Marshal(scanned)
+	var decoded StringSet
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, s) {
+		t.Errorf("StringSet JSON round trip = %v, want %v", decoded, s)
+	}
+}
+
+func TestStringSet_RoundTripComma(t *testing.T) {
+	s := NewStringSet("a,b", "c", `d\e`)
+
+	v, err := s.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != `a\,b,c,d\\e` {
+		t.Errorf("StringSet.Value() = %v", v)
+	}
+
+	var scanned StringSet
+	if err := scanned.Scan([]byte(v.(string))); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(scanned, s) || !scanned.Equal(s) {
+		t.Errorf("StringSet.Scan() = %q, want %q", scanned, s)
+	}
+}
@@ -22,6 +22,10 @@ func (x *StringSlice) Scan(src interface{}) error {
 		*x = tmp
 		return nil
 
+	case string:
+		*x = strings.Split(s, ",")
+		return nil
+
 	default:
 		return errors.New("incompatible type to scan")
 	}